@@ -0,0 +1,30 @@
+resource "contentful_entry" "article" {
+  entry_id       = "article"
+  space_id       = "space-id"
+  contenttype_id = "article"
+  environment    = "master"
+  field {
+    id      = "title"
+    content = "Release notes"
+    locale  = "en-US"
+  }
+  field {
+    id      = "body"
+    content = provider::contentful::markdown_to_rich_text(file("${path.module}/release-notes.md"))
+    locale  = "en-US"
+  }
+  published = true
+  archived  = false
+}
+
+# Pass the validations of the Rich Text field to leave out the markup that
+# the field does not allow
+output "summary" {
+  value = provider::contentful::markdown_to_rich_text(
+    "## Summary\n\nOnly **bold** text and headings are kept",
+    {
+      enabled_node_types = ["heading-2"]
+      enabled_marks      = ["bold"]
+    },
+  )
+}
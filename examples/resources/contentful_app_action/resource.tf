@@ -0,0 +1,30 @@
+resource "contentful_app_definition" "example_app_definition" {
+  name       = "test_app_definition"
+  use_bundle = false
+  src        = "http://localhost:3000"
+  locations  = [{ location = "app-config" }, { location = "dialog" }, { location = "entry-editor" }]
+}
+
+resource "contentful_app_action" "notify" {
+  app_definition_id = contentful_app_definition.example_app_definition.id
+  name              = "Notify"
+  description       = "Sends a notification to the configured channel"
+  type              = "endpoint"
+  url               = "https://example.com/actions/notify"
+  category          = "Custom"
+
+  parameters = [
+    {
+      id       = "message"
+      name     = "Message"
+      type     = "Symbol"
+      required = true
+    },
+    {
+      id      = "channel"
+      name    = "Channel"
+      type    = "Enum"
+      options = ["email", "slack"]
+    },
+  ]
+}
@@ -0,0 +1,15 @@
+# Bundles belong to an app definition, so the app definition needs to exist
+# before its bundles can be created.
+variable "app_definition_id" {
+  type = string
+}
+
+resource "contentful_app_bundle" "example_app_bundle" {
+  app_definition_id = var.app_definition_id
+  source            = "${path.module}/frontend/build"
+  comment           = "Release 1.2.0"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
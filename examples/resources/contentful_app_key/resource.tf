@@ -0,0 +1,17 @@
+resource "contentful_app_definition" "example_app_definition" {
+  name       = "test_app_definition"
+  use_bundle = false
+  src        = "http://localhost:3000"
+  locations  = [{ location = "app-config" }, { location = "dialog" }, { location = "entry-editor" }]
+}
+
+# Let Contentful generate a key pair, the private key is stored in the state
+resource "contentful_app_key" "generated" {
+  app_definition_id = contentful_app_definition.example_app_definition.id
+}
+
+# Or upload the certificate of an existing key pair
+resource "contentful_app_key" "existing" {
+  app_definition_id = contentful_app_definition.example_app_definition.id
+  public_key        = file("${path.module}/app-key.crt")
+}
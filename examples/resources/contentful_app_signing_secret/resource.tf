@@ -0,0 +1,16 @@
+resource "contentful_app_definition" "example_app_definition" {
+  name       = "test_app_definition"
+  use_bundle = false
+  src        = "http://localhost:3000"
+  locations  = [{ location = "app-config" }, { location = "dialog" }, { location = "entry-editor" }]
+}
+
+resource "random_password" "signing_secret" {
+  length  = 64
+  special = false
+}
+
+resource "contentful_app_signing_secret" "example_app_signing_secret" {
+  app_definition_id = contentful_app_definition.example_app_definition.id
+  value             = random_password.signing_secret.result
+}
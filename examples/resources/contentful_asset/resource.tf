@@ -4,20 +4,78 @@ resource "contentful_asset" "example_asset" {
   space_id    = "space-id"
 
   fields {
-    title {
-      locale  = "en-US"
-      content = "asset title"
+    title = {
+      "en-US" = "asset title"
     }
-    description {
-      locale  = "en-US"
-      content = "asset description"
+    description = {
+      "en-US" = "asset description"
     }
-    file {
-      upload      = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      fileName    = "example.jpeg"
-      contentType = "image/jpeg"
+    file = {
+      "en-US" = {
+        upload       = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name    = "example.svg"
+        content_type = "image/svg+xml"
+      }
     }
   }
   published = false
   archived  = false
 }
+
+# Upload a file from the repository, only a change of the file uploads and
+# processes it again
+resource "contentful_asset" "favicon" {
+  asset_id    = "favicon"
+  environment = "master"
+  space_id    = "space-id"
+
+  fields {
+    title = {
+      "en-US" = "Favicon"
+    }
+    description = {
+      "en-US" = "Favicon of the website"
+    }
+    file = {
+      "en-US" = {
+        source       = "${path.module}/favicon.png"
+        file_name    = "favicon.png"
+        content_type = "image/png"
+      }
+    }
+  }
+  published = true
+  archived  = false
+
+  # Limit how long to wait for Contentful to process the file
+  timeouts {
+    create = "10m"
+    update = "10m"
+  }
+}
+
+# Upload content generated in the configuration, use content_base64 for binary
+# content
+resource "contentful_asset" "site_config" {
+  asset_id    = "site_config"
+  environment = "master"
+  space_id    = "space-id"
+
+  fields {
+    title = {
+      "en-US" = "Site configuration"
+    }
+    file = {
+      "en-US" = {
+        content = jsonencode({
+          search_enabled = true
+          items_per_page = 20
+        })
+        file_name    = "config.json"
+        content_type = "application/json"
+      }
+    }
+  }
+  published = true
+  archived  = false
+}
@@ -20,7 +20,18 @@ resource "contentful_contenttype" "example_contenttype" {
   id            = "tf_linked"
   name          = "tf_linked"
   description   = "content type description"
-  display_field = "asset_field"
+  display_field = "select"
+  annotations   = ["Contentful:AggregateRoot"]
+
+  taxonomy = [
+    {
+      concept_scheme_id = "topics"
+      required          = true
+    },
+    {
+      concept_id = "featured"
+    }
+  ]
 
   fields = [
     {
@@ -30,9 +41,28 @@ resource "contentful_contenttype" "example_contenttype" {
       items = {
         type      = "Link"
         link_type = "Asset"
+        validations = [{
+          asset_image_dimensions = {
+            width = {
+              min = 320
+            }
+          }
+        }]
       }
       required = true
     },
+    {
+      id   = "launch_date"
+      name = "Launch Date"
+      type = "Date"
+      validations = [{
+        date_range = {
+          min = "2026-01-01"
+        }
+        message = "The launch date can't be before 2026"
+      }]
+      required = false
+    },
     {
       id        = "entry_link_field"
       name      = "Entry Link Field"
@@ -43,7 +73,8 @@ resource "contentful_contenttype" "example_contenttype" {
           link_content_type = [contentful_contenttype.some_other_content_type.id]
         }
       ]
-      required = false
+      annotations = ["Contentful:AggregateComponent"]
+      required    = false
     },
     {
       id       = "select",
@@ -35,7 +35,78 @@ resource "contentful_entry" "example_entry" {
       nodeType = "document"
     })
   }
+  # Link to another entry without writing the Link object by hand
+  field {
+    id         = "related"
+    locale     = "en-US"
+    entry_link = contentful_entry.fields_entry.id
+  }
+
+  # Only manage the campaign tag, tags that editors add are kept
+  tags                  = ["campaign"]
+  ignore_unmanaged_tags = true
+
   published  = false
   archived   = false
   depends_on = [contentful_contenttype.mycontenttype]
 }
+
+# Set the fields as a map of field IDs to locales, numbers, lists and objects
+# keep their type so they don't need jsonencode
+resource "contentful_entry" "fields_entry" {
+  entry_id       = "myfieldsentry"
+  space_id       = "space-id"
+  contenttype_id = "type-id"
+  environment    = "master"
+  fields = {
+    field1 = {
+      "en-US" = "Hello, World!"
+      "de-DE" = "Hallo Welt!"
+    }
+    field2 = {
+      "en-US" = "Lettuce is healthy!"
+    }
+    content = {
+      "en-US" = {
+        nodeType = "document"
+        data     = {}
+        content = [
+          {
+            nodeType = "paragraph"
+            data     = {}
+            content = [
+              {
+                nodeType = "text"
+                marks    = []
+                value    = "This is a paragraph"
+                data     = {}
+              },
+            ]
+          }
+        ]
+      }
+    }
+  }
+  published  = false
+  archived   = false
+  depends_on = [contentful_contenttype.mycontenttype]
+}
+
+# Create the site settings once and leave the content to the editors, later
+# changes in Contentful are kept and the entry stays published
+resource "contentful_entry" "site_settings" {
+  entry_id       = "site-settings"
+  space_id       = "space-id"
+  contenttype_id = "type-id"
+  environment    = "master"
+  lifecycle_mode = "seed"
+  fields = {
+    field1 = {
+      "en-US" = "My site"
+    }
+  }
+  published       = true
+  archived        = false
+  deletion_policy = "retain"
+  depends_on      = [contentful_contenttype.mycontenttype]
+}
@@ -0,0 +1,16 @@
+resource "contentful_release" "example_release" {
+  space_id    = "space-id"
+  environment = "master"
+
+  title = "Spring campaign"
+  entities = [
+    {
+      id        = contentful_entry.example_entry.id
+      link_type = "Entry"
+    },
+    {
+      id        = contentful_asset.example_asset.id
+      link_type = "Asset"
+    },
+  ]
+}
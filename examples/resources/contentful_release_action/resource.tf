@@ -0,0 +1,8 @@
+resource "contentful_release_action" "publish_release" {
+  space_id    = "space-id"
+  environment = "master"
+
+  release_id      = contentful_release.example_release.id
+  release_version = contentful_release.example_release.version
+  action          = "publish"
+}
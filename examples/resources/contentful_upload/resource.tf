@@ -0,0 +1,29 @@
+resource "contentful_upload" "terms" {
+  space_id    = "space-id"
+  environment = "master"
+  source      = "${path.module}/terms-and-conditions.pdf"
+}
+
+resource "contentful_asset" "terms" {
+  asset_id    = "terms-and-conditions"
+  environment = "master"
+  space_id    = "space-id"
+
+  fields {
+    title = {
+      "en-US" = "Terms and conditions"
+    }
+    description = {
+      "en-US" = "Terms and conditions"
+    }
+    file = {
+      "en-US" = {
+        upload_from  = contentful_upload.terms.id
+        file_name    = "terms-and-conditions.pdf"
+        content_type = "application/pdf"
+      }
+    }
+  }
+  published = true
+  archived  = false
+}
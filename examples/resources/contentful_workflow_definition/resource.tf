@@ -0,0 +1,38 @@
+resource "contentful_workflow_definition" "editorial" {
+  space_id    = "space-id"
+  environment = "master"
+
+  name          = "Editorial review"
+  description   = "Articles need to be reviewed before they can be published"
+  content_types = [contentful_contenttype.article.id]
+
+  steps = [
+    {
+      name  = "In review"
+      color = "blue"
+      actions = [
+        {
+          type = "email"
+          configuration = jsonencode({
+            recipients = [{ sys = { type = "Link", linkType = "User", id = "user-id" } }]
+          })
+        }
+      ]
+      permissions = [
+        {
+          action = "publish"
+          effect = "deny"
+        },
+        {
+          action = "publish"
+          effect = "allow"
+          roles  = [contentful_role.editor.id]
+        }
+      ]
+    },
+    {
+      name  = "Approved"
+      color = "green"
+    },
+  ]
+}
@@ -0,0 +1,149 @@
+package markdown_to_rich_text
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = &markdownToRichTextFunction{}
+)
+
+func NewMarkdownToRichTextFunction() function.Function {
+	return &markdownToRichTextFunction{}
+}
+
+// markdownToRichTextFunction is the function implementation.
+type markdownToRichTextFunction struct{}
+
+func (f *markdownToRichTextFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "markdown_to_rich_text"
+}
+
+func (f *markdownToRichTextFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Convert Markdown to a Contentful Rich Text document",
+		MarkdownDescription: "Converts CommonMark with headings, lists, links, bold, italic, code, blockquotes, horizontal rules " +
+			"and tables to a Contentful Rich Text document, encoded as JSON for the content of an entry field. " +
+			"Node types and marks can be limited with objects that have `enabled_node_types` and `enabled_marks`, " +
+			"like the validations of a Rich Text field of `contentful_contenttype`. Markup that is not enabled is " +
+			"replaced by its text.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "markdown",
+				Description: "The Markdown to convert",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name: "validations",
+			Description: "Objects with enabled_node_types and enabled_marks lists that limit the node types and marks of the " +
+				"document, other attributes are ignored",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *markdownToRichTextFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var markdown string
+	var validations []types.Dynamic
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &markdown, &validations))
+	if response.Error != nil {
+		return
+	}
+
+	var options Options
+	for i, validation := range validations {
+		if err := options.add(validation); err != nil {
+			response.Error = function.NewArgumentFuncError(int64(i+1), err.Error())
+			return
+		}
+	}
+
+	data, err := json.Marshal(Convert(markdown, options))
+	if err != nil {
+		response.Error = function.NewFuncError("Could not encode the document: " + err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, string(data)))
+}
+
+// add reads the enabled node types and marks of a validation object
+func (o *Options) add(validation types.Dynamic) error {
+	if validation.IsNull() {
+		return nil
+	}
+
+	var attributes map[string]attr.Value
+	switch v := validation.UnderlyingValue().(type) {
+	case types.Object:
+		attributes = v.Attributes()
+	case types.Map:
+		attributes = v.Elements()
+	default:
+		return fmt.Errorf("expected an object with enabled_node_types and enabled_marks")
+	}
+
+	nodeTypes, err := stringValues(attributes["enabled_node_types"])
+	if err != nil {
+		return fmt.Errorf("enabled_node_types %w", err)
+	}
+	if nodeTypes != nil {
+		// An empty list disables all node types that can be disabled
+		o.EnabledNodeTypes = append(nonNil(o.EnabledNodeTypes), nodeTypes...)
+	}
+
+	marks, err := stringValues(attributes["enabled_marks"])
+	if err != nil {
+		return fmt.Errorf("enabled_marks %w", err)
+	}
+	if marks != nil {
+		o.EnabledMarks = append(nonNil(o.EnabledMarks), marks...)
+	}
+	return nil
+}
+
+// stringValues returns the strings of a list, or nil when the value is not set
+func stringValues(value attr.Value) ([]string, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.Dynamic:
+		return stringValues(v.UnderlyingValue())
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("needs to be a list of strings")
+	}
+
+	result := []string{}
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("needs to be a list of strings")
+		}
+		result = append(result, s.ValueString())
+	}
+	return result, nil
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
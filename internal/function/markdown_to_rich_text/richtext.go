@@ -0,0 +1,346 @@
+package markdown_to_rich_text
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// Options limits the node types and marks of the document, like the
+// enabledNodeTypes and enabledMarks validations of a rich text field. A nil
+// slice enables everything.
+type Options struct {
+	EnabledNodeTypes []string
+	EnabledMarks     []string
+}
+
+// alwaysEnabled are the node types that can't be disabled in Contentful
+var alwaysEnabled = []string{"document", "paragraph", "text", "list-item", "table-row", "table-cell", "table-header-cell"}
+
+func (o Options) nodeEnabled(nodeType string) bool {
+	return o.EnabledNodeTypes == nil || slices.Contains(alwaysEnabled, nodeType) || slices.Contains(o.EnabledNodeTypes, nodeType)
+}
+
+func (o Options) markEnabled(mark string) bool {
+	return o.EnabledMarks == nil || slices.Contains(o.EnabledMarks, mark)
+}
+
+var parser = goldmark.New(goldmark.WithExtensions(extension.Table, extension.Strikethrough)).Parser()
+
+// Convert converts CommonMark to a Contentful rich text document. Node types
+// and marks that are not enabled are replaced by their content, so the
+// document can be stored in the field.
+func Convert(markdown string, options Options) map[string]any {
+	source := []byte(markdown)
+	c := converter{source: source, options: options}
+	return node("document", c.blocks(parser.Parse(text.NewReader(source))), nil)
+}
+
+type converter struct {
+	source  []byte
+	options Options
+}
+
+func node(nodeType string, content []any, data map[string]any) map[string]any {
+	if data == nil {
+		data = map[string]any{}
+	}
+	return map[string]any{
+		"nodeType": nodeType,
+		"data":     data,
+		"content":  content,
+	}
+}
+
+func textNode(value string, marks []string) map[string]any {
+	markValues := make([]any, 0, len(marks))
+	for _, mark := range marks {
+		markValues = append(markValues, map[string]any{"type": mark})
+	}
+	return map[string]any{
+		"nodeType": "text",
+		"value":    value,
+		"marks":    markValues,
+		"data":     map[string]any{},
+	}
+}
+
+// paragraph creates a paragraph, which needs at least a single text node
+func paragraph(content []any) map[string]any {
+	if len(content) == 0 {
+		content = []any{textNode("", nil)}
+	}
+	return node("paragraph", content, nil)
+}
+
+// paragraphs replaces the blocks by paragraphs, for the places where
+// Contentful only allows paragraphs like in a blockquote.
+func paragraphs(blocks []any) []any {
+	result := []any{}
+	for _, block := range blocks {
+		n := block.(map[string]any)
+		nodeType := n["nodeType"].(string)
+		switch {
+		case nodeType == "paragraph":
+			result = append(result, n)
+		case strings.HasPrefix(nodeType, "heading-"):
+			result = append(result, paragraph(n["content"].([]any)))
+		case nodeType == "hr":
+		default:
+			result = append(result, paragraphs(n["content"].([]any))...)
+		}
+	}
+	return result
+}
+
+func (c *converter) blocks(parent ast.Node) []any {
+	result := []any{}
+	for child := parent.FirstChild(); child != nil; child = child.NextSibling() {
+		result = append(result, c.block(child)...)
+	}
+	return result
+}
+
+func (c *converter) block(n ast.Node) []any {
+	switch n := n.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		return []any{paragraph(c.inlineContent(n))}
+
+	case *ast.Heading:
+		nodeType := fmt.Sprintf("heading-%d", n.Level)
+		if !c.options.nodeEnabled(nodeType) {
+			return []any{paragraph(c.inlineContent(n))}
+		}
+		return []any{node(nodeType, c.inlineContent(n), nil)}
+
+	case *ast.List:
+		nodeType := "unordered-list"
+		if n.IsOrdered() {
+			nodeType = "ordered-list"
+		}
+
+		enabled := c.options.nodeEnabled(nodeType)
+		result := []any{}
+		for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+			content := c.listItemContent(item)
+			if !enabled {
+				result = append(result, content...)
+				continue
+			}
+			result = append(result, node("list-item", content, nil))
+		}
+		if !enabled {
+			return result
+		}
+		return []any{node(nodeType, result, nil)}
+
+	case *ast.Blockquote:
+		content := paragraphs(c.blocks(n))
+		if !c.options.nodeEnabled("blockquote") {
+			return content
+		}
+		return []any{node("blockquote", content, nil)}
+
+	case *ast.ThematicBreak:
+		if !c.options.nodeEnabled("hr") {
+			return nil
+		}
+		return []any{node("hr", []any{}, nil)}
+
+	case *ast.FencedCodeBlock, *ast.CodeBlock:
+		value := strings.TrimSuffix(c.lines(n), "\n")
+		return []any{paragraph(c.text(value, []string{"code"}))}
+
+	case *ast.HTMLBlock:
+		value := strings.TrimSuffix(c.lines(n), "\n")
+		return []any{paragraph(c.text(value, nil))}
+
+	case *east.Table:
+		return c.table(n)
+	}
+
+	return c.blocks(n)
+}
+
+// listItemContent returns the content of a list item, which can hold
+// paragraphs and nested lists.
+func (c *converter) listItemContent(item ast.Node) []any {
+	result := []any{}
+	for _, block := range c.blocks(item) {
+		switch block.(map[string]any)["nodeType"] {
+		case "ordered-list", "unordered-list":
+			result = append(result, block)
+		default:
+			result = append(result, paragraphs([]any{block})...)
+		}
+	}
+	if len(result) == 0 {
+		result = append(result, paragraph(nil))
+	}
+	return result
+}
+
+func (c *converter) table(n *east.Table) []any {
+	enabled := c.options.nodeEnabled("table")
+
+	rows := []any{}
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		cellType := "table-cell"
+		if _, ok := row.(*east.TableHeader); ok {
+			cellType = "table-header-cell"
+		}
+
+		cells := []any{}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			content := c.inlineContent(cell)
+			if !enabled {
+				// Without tables every row becomes a paragraph
+				if len(cells) > 0 {
+					cells = append(cells, c.text(" | ", nil)...)
+				}
+				cells = append(cells, content...)
+				continue
+			}
+			cells = append(cells, node(cellType, []any{paragraph(content)}, nil))
+		}
+
+		if !enabled {
+			rows = append(rows, paragraph(mergeText(cells)))
+			continue
+		}
+		rows = append(rows, node("table-row", cells, nil))
+	}
+
+	if !enabled {
+		return rows
+	}
+	return []any{node("table", rows, nil)}
+}
+
+func (c *converter) lines(n ast.Node) string {
+	var b strings.Builder
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		b.Write(segment.Value(c.source))
+	}
+	return b.String()
+}
+
+// inlineContent converts the inline children of a block to text and
+// hyperlink nodes.
+func (c *converter) inlineContent(parent ast.Node) []any {
+	return mergeText(c.inlines(parent, nil))
+}
+
+func (c *converter) inlines(parent ast.Node, marks []string) []any {
+	result := []any{}
+	for child := parent.FirstChild(); child != nil; child = child.NextSibling() {
+		result = append(result, c.inline(child, marks)...)
+	}
+	return result
+}
+
+func (c *converter) inline(n ast.Node, marks []string) []any {
+	switch n := n.(type) {
+	case *ast.Text:
+		value := string(n.Segment.Value(c.source))
+		if n.HardLineBreak() {
+			value += "\n"
+		} else if n.SoftLineBreak() {
+			value += " "
+		}
+		return c.text(value, marks)
+
+	case *ast.String:
+		return c.text(string(n.Value), marks)
+
+	case *ast.CodeSpan:
+		return c.inlines(n, withMark(marks, "code"))
+
+	case *ast.Emphasis:
+		mark := "italic"
+		if n.Level == 2 {
+			mark = "bold"
+		}
+		return c.inlines(n, withMark(marks, mark))
+
+	case *east.Strikethrough:
+		return c.inlines(n, withMark(marks, "strikethrough"))
+
+	case *ast.Link:
+		return c.hyperlink(string(n.Destination), c.inlines(n, marks))
+
+	case *ast.Image:
+		// Rich text can't hold external images, so they are linked
+		return c.hyperlink(string(n.Destination), c.inlines(n, marks))
+
+	case *ast.AutoLink:
+		return c.hyperlink(string(n.URL(c.source)), c.text(string(n.Label(c.source)), marks))
+
+	case *ast.RawHTML:
+		var b strings.Builder
+		for i := 0; i < n.Segments.Len(); i++ {
+			segment := n.Segments.At(i)
+			b.Write(segment.Value(c.source))
+		}
+		return c.text(b.String(), marks)
+	}
+
+	return c.inlines(n, marks)
+}
+
+func (c *converter) text(value string, marks []string) []any {
+	enabled := []string{}
+	for _, mark := range marks {
+		if c.options.markEnabled(mark) {
+			enabled = append(enabled, mark)
+		}
+	}
+	return []any{textNode(value, enabled)}
+}
+
+func (c *converter) hyperlink(uri string, content []any) []any {
+	if !c.options.nodeEnabled("hyperlink") {
+		return content
+	}
+	if len(content) == 0 {
+		content = c.text(uri, nil)
+	}
+	return []any{node("hyperlink", mergeText(content), map[string]any{"uri": uri})}
+}
+
+func withMark(marks []string, mark string) []string {
+	if slices.Contains(marks, mark) {
+		return marks
+	}
+	return append(slices.Clone(marks), mark)
+}
+
+// mergeText joins adjacent text nodes with the same marks, the parser splits
+// text on characters that could start markup.
+func mergeText(content []any) []any {
+	result := []any{}
+	for _, item := range content {
+		current := item.(map[string]any)
+		if len(result) > 0 {
+			previous := result[len(result)-1].(map[string]any)
+			if previous["nodeType"] == "text" && current["nodeType"] == "text" && sameMarks(previous, current) {
+				previous["value"] = previous["value"].(string) + current["value"].(string)
+				continue
+			}
+		}
+		result = append(result, current)
+	}
+	return result
+}
+
+func sameMarks(a, b map[string]any) bool {
+	return fmt.Sprint(a["marks"]) == fmt.Sprint(b["marks"])
+}
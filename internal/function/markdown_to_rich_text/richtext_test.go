@@ -0,0 +1,141 @@
+package markdown_to_rich_text
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toJSON(t *testing.T, value any) string {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestConvert_Inline(t *testing.T) {
+	document := Convert("Hello **bold** _italic_ `code` [link](https://example.com)", Options{})
+
+	assert.JSONEq(t, `{
+		"nodeType": "document", "data": {}, "content": [
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "value": "Hello ", "marks": [], "data": {}},
+				{"nodeType": "text", "value": "bold", "marks": [{"type": "bold"}], "data": {}},
+				{"nodeType": "text", "value": " ", "marks": [], "data": {}},
+				{"nodeType": "text", "value": "italic", "marks": [{"type": "italic"}], "data": {}},
+				{"nodeType": "text", "value": " ", "marks": [], "data": {}},
+				{"nodeType": "text", "value": "code", "marks": [{"type": "code"}], "data": {}},
+				{"nodeType": "text", "value": " ", "marks": [], "data": {}},
+				{"nodeType": "hyperlink", "data": {"uri": "https://example.com"}, "content": [
+					{"nodeType": "text", "value": "link", "marks": [], "data": {}}
+				]}
+			]}
+		]
+	}`, toJSON(t, document))
+}
+
+func TestConvert_Blocks(t *testing.T) {
+	document := Convert("## Title\n\n- one\n- two\n\n> quote\n\n---\n", Options{})
+
+	text := func(value string) string {
+		return `{"nodeType": "text", "value": "` + value + `", "marks": [], "data": {}}`
+	}
+	paragraph := func(value string) string {
+		return `{"nodeType": "paragraph", "data": {}, "content": [` + text(value) + `]}`
+	}
+
+	assert.JSONEq(t, `{
+		"nodeType": "document", "data": {}, "content": [
+			{"nodeType": "heading-2", "data": {}, "content": [`+text("Title")+`]},
+			{"nodeType": "unordered-list", "data": {}, "content": [
+				{"nodeType": "list-item", "data": {}, "content": [`+paragraph("one")+`]},
+				{"nodeType": "list-item", "data": {}, "content": [`+paragraph("two")+`]}
+			]},
+			{"nodeType": "blockquote", "data": {}, "content": [`+paragraph("quote")+`]},
+			{"nodeType": "hr", "data": {}, "content": []}
+		]
+	}`, toJSON(t, document))
+}
+
+func TestConvert_Table(t *testing.T) {
+	markdown := "| Name | Price |\n|------|-------|\n| Tea | 3 |\n"
+
+	document := Convert(markdown, Options{})
+	table := document["content"].([]any)[0].(map[string]any)
+	assert.Equal(t, "table", table["nodeType"])
+
+	rows := table["content"].([]any)
+	require.Len(t, rows, 2)
+	header := rows[0].(map[string]any)["content"].([]any)[0].(map[string]any)
+	assert.Equal(t, "table-header-cell", header["nodeType"])
+	cell := rows[1].(map[string]any)["content"].([]any)[0].(map[string]any)
+	assert.Equal(t, "table-cell", cell["nodeType"])
+
+	// Without tables every row becomes a paragraph
+	document = Convert(markdown, Options{EnabledNodeTypes: []string{}})
+	assert.JSONEq(t, `[
+		{"nodeType": "paragraph", "data": {}, "content": [{"nodeType": "text", "value": "Name | Price", "marks": [], "data": {}}]},
+		{"nodeType": "paragraph", "data": {}, "content": [{"nodeType": "text", "value": "Tea | 3", "marks": [], "data": {}}]}
+	]`, toJSON(t, document["content"]))
+}
+
+func TestConvert_Disabled(t *testing.T) {
+	options := Options{
+		EnabledNodeTypes: []string{"unordered-list"},
+		EnabledMarks:     []string{"italic"},
+	}
+	document := Convert("# Title\n\n**bold** _italic_ [link](https://example.com)\n\n1. one\n\n---\n", options)
+
+	assert.JSONEq(t, `{
+		"nodeType": "document", "data": {}, "content": [
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "value": "Title", "marks": [], "data": {}}
+			]},
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "value": "bold ", "marks": [], "data": {}},
+				{"nodeType": "text", "value": "italic", "marks": [{"type": "italic"}], "data": {}},
+				{"nodeType": "text", "value": " link", "marks": [], "data": {}}
+			]},
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "value": "one", "marks": [], "data": {}}
+			]}
+		]
+	}`, toJSON(t, document))
+}
+
+func TestMarkdownToRichTextFunction_Run(t *testing.T) {
+	validationType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"enabled_node_types": types.ListType{ElemType: types.StringType},
+		"enabled_marks":      types.ListType{ElemType: types.StringType},
+	}}
+	validation := types.DynamicValue(types.ObjectValueMust(validationType.AttrTypes, map[string]attr.Value{
+		"enabled_node_types": types.ListValueMust(types.StringType, []attr.Value{}),
+		"enabled_marks":      types.ListNull(types.StringType),
+	}))
+
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("# Title"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{validation}),
+		}),
+	}
+	response := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewMarkdownToRichTextFunction().Run(context.Background(), request, &response)
+	require.Nil(t, response.Error)
+
+	result, ok := response.Result.Value().(types.String)
+	require.True(t, ok)
+	assert.JSONEq(t, `{
+		"nodeType": "document", "data": {}, "content": [
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "value": "Title", "marks": [], "data": {}}
+			]}
+		]
+	}`, result.ValueString())
+}
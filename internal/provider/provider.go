@@ -7,15 +7,21 @@ import (
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_event_subscription"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	datasourcespace "github.com/labd/terraform-provider-contentful/internal/datasource/space"
+	"github.com/labd/terraform-provider-contentful/internal/function/markdown_to_rich_text"
 	"github.com/labd/terraform-provider-contentful/internal/resources/api_key"
+	"github.com/labd/terraform-provider-contentful/internal/resources/app_action"
+	"github.com/labd/terraform-provider-contentful/internal/resources/app_bundle"
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_definition"
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_installation"
+	"github.com/labd/terraform-provider-contentful/internal/resources/app_key"
+	"github.com/labd/terraform-provider-contentful/internal/resources/app_signing_secret"
 	"github.com/labd/terraform-provider-contentful/internal/resources/asset"
 	"github.com/labd/terraform-provider-contentful/internal/resources/contenttype"
 	"github.com/labd/terraform-provider-contentful/internal/resources/editor_interface"
@@ -24,14 +30,19 @@ import (
 	"github.com/labd/terraform-provider-contentful/internal/resources/environment_alias"
 	"github.com/labd/terraform-provider-contentful/internal/resources/locale"
 	"github.com/labd/terraform-provider-contentful/internal/resources/preview_environment"
+	"github.com/labd/terraform-provider-contentful/internal/resources/release"
+	"github.com/labd/terraform-provider-contentful/internal/resources/release_action"
 	"github.com/labd/terraform-provider-contentful/internal/resources/role"
 	"github.com/labd/terraform-provider-contentful/internal/resources/space"
+	"github.com/labd/terraform-provider-contentful/internal/resources/upload"
 	"github.com/labd/terraform-provider-contentful/internal/resources/webhook"
+	"github.com/labd/terraform-provider-contentful/internal/resources/workflow_definition"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 var (
-	_ provider.Provider = &contentfulProvider{}
+	_ provider.Provider              = &contentfulProvider{}
+	_ provider.ProviderWithFunctions = &contentfulProvider{}
 )
 
 func New(version string, debug bool) func() provider.Provider {
@@ -138,6 +149,7 @@ func (c contentfulProvider) Configure(ctx context.Context, request provider.Conf
 		Client:         clientNew,
 		ClientUpload:   clientUpload,
 		OrganizationId: organizationId,
+		Cache:          utils.NewCache(),
 	}
 
 	response.ResourceData = data
@@ -150,12 +162,22 @@ func (c contentfulProvider) DataSources(_ context.Context) []func() datasource.D
 	}
 }
 
+func (c contentfulProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		markdown_to_rich_text.NewMarkdownToRichTextFunction,
+	}
+}
+
 func (c contentfulProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		api_key.NewApiKeyResource,
+		app_action.NewAppActionResource,
+		app_bundle.NewAppBundleResource,
 		app_definition.NewAppDefinitionResource,
 		app_installation.NewAppInstallationResource,
 		app_event_subscription.NewAppEventSubscriptionResource,
+		app_key.NewAppKeyResource,
+		app_signing_secret.NewAppSigningSecretResource,
 		asset.NewAssetResource,
 		contenttype.NewContentTypeResource,
 		editor_interface.NewEditorInterfaceResource,
@@ -164,8 +186,12 @@ func (c contentfulProvider) Resources(_ context.Context) []func() resource.Resou
 		environment_alias.NewEnvironmentAliasResource,
 		locale.NewLocaleResource,
 		preview_environment.NewPreviewEnvironmentResource,
+		release.NewReleaseResource,
+		release_action.NewReleaseActionResource,
 		role.NewRoleResource,
 		space.NewSpaceResource,
+		upload.NewUploadResource,
 		webhook.NewWebhookResource,
+		workflow_definition.NewWorkflowDefinitionResource,
 	}
 }
@@ -0,0 +1,185 @@
+package app_action
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// AppAction is the main resource schema data
+type AppAction struct {
+	ID              types.String `tfsdk:"id"`
+	AppDefinitionID types.String `tfsdk:"app_definition_id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Type            types.String `tfsdk:"type"`
+	Url             types.String `tfsdk:"url"`
+	FunctionID      types.String `tfsdk:"function_id"`
+	Category        types.String `tfsdk:"category"`
+	Parameters      []Parameter  `tfsdk:"parameters"`
+}
+
+type Parameter struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Type        types.String   `tfsdk:"type"`
+	Required    types.Bool     `tfsdk:"required"`
+	Options     []types.String `tfsdk:"options"`
+}
+
+func (a *AppAction) Draft() *sdk.AppActionDraft {
+	draft := &sdk.AppActionDraft{
+		Name:     a.Name.ValueString(),
+		Type:     sdk.AppActionDraftType(a.Type.ValueString()),
+		Category: sdk.AppActionDraftCategory(a.Category.ValueString()),
+	}
+
+	if !a.Description.IsNull() && !a.Description.IsUnknown() {
+		draft.Description = a.Description.ValueStringPointer()
+	}
+
+	if !a.Url.IsNull() && !a.Url.IsUnknown() {
+		draft.Url = a.Url.ValueStringPointer()
+	}
+
+	if !a.FunctionID.IsNull() && !a.FunctionID.IsUnknown() {
+		draft.Function = &sdk.SystemPropertiesReference{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: "Function",
+				Id:       a.FunctionID.ValueString(),
+			},
+		}
+	}
+
+	if a.Parameters != nil {
+		parameters := make([]sdk.AppActionParameter, 0, len(a.Parameters))
+		for _, parameter := range a.Parameters {
+			parameters = append(parameters, parameter.Draft())
+		}
+		draft.Parameters = &parameters
+	}
+
+	return draft
+}
+
+func (p *Parameter) Draft() sdk.AppActionParameter {
+	parameter := sdk.AppActionParameter{
+		Id:       p.ID.ValueString(),
+		Name:     p.Name.ValueString(),
+		Type:     sdk.AppActionParameterType(p.Type.ValueString()),
+		Required: p.Required.ValueBoolPointer(),
+	}
+
+	if !p.Description.IsNull() && !p.Description.IsUnknown() {
+		parameter.Description = p.Description.ValueStringPointer()
+	}
+
+	if p.Options != nil {
+		options := make([]string, 0, len(p.Options))
+		for _, option := range p.Options {
+			options = append(options, option.ValueString())
+		}
+		parameter.Options = &options
+	}
+
+	return parameter
+}
+
+func (a *AppAction) Import(n *sdk.AppAction) {
+	a.ID = types.StringValue(n.Sys.Id)
+	a.Name = types.StringValue(n.Name)
+	a.Description = types.StringPointerValue(n.Description)
+	a.Type = types.StringValue(string(n.Type))
+	a.Url = types.StringPointerValue(n.Url)
+	a.Category = types.StringValue(string(n.Category))
+
+	a.FunctionID = types.StringNull()
+	if n.Function != nil {
+		a.FunctionID = types.StringValue(n.Function.Sys.Id)
+	}
+
+	a.Parameters = nil
+	if n.Parameters != nil && len(*n.Parameters) > 0 {
+		for _, parameter := range *n.Parameters {
+			item := Parameter{
+				ID:          types.StringValue(parameter.Id),
+				Name:        types.StringValue(parameter.Name),
+				Description: types.StringPointerValue(parameter.Description),
+				Type:        types.StringValue(string(parameter.Type)),
+				Required:    types.BoolValue(parameter.Required != nil && *parameter.Required),
+			}
+
+			if parameter.Options != nil {
+				for _, option := range *parameter.Options {
+					item.Options = append(item.Options, types.StringValue(option))
+				}
+			}
+
+			a.Parameters = append(a.Parameters, item)
+		}
+	}
+}
+
+// Validate checks the combinations of attributes that Contentful accepts for
+// app actions, so invalid configurations fail during plan.
+func (a *AppAction) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !a.Type.IsUnknown() {
+		isEndpoint := a.Type.ValueString() == string(sdk.AppActionTypeEndpoint)
+		isFunction := a.Type.ValueString() == string(sdk.AppActionTypeFunction)
+
+		if isEndpoint && a.Url.IsNull() {
+			diags.AddAttributeError(path.Root("url"), "Missing url", "url needs to be set for app actions of type endpoint")
+		}
+		if !isEndpoint && !a.Url.IsNull() {
+			diags.AddAttributeError(path.Root("url"), "Invalid url", "url can only be set for app actions of type endpoint")
+		}
+		if isFunction && a.FunctionID.IsNull() {
+			diags.AddAttributeError(path.Root("function_id"), "Missing function_id", "function_id needs to be set for app actions of type function")
+		}
+		if !isFunction && !a.FunctionID.IsNull() {
+			diags.AddAttributeError(path.Root("function_id"), "Invalid function_id", "function_id can only be set for app actions of type function")
+		}
+	}
+
+	if !a.Category.IsUnknown() && a.Category.ValueString() != string(sdk.AppActionCategoryCustom) && len(a.Parameters) > 0 {
+		diags.AddAttributeError(
+			path.Root("parameters"),
+			"Invalid parameters",
+			fmt.Sprintf("parameters can only be defined for the %s category, the parameters of %s are defined by Contentful", sdk.AppActionCategoryCustom, a.Category.ValueString()),
+		)
+	}
+
+	ids := map[string]bool{}
+	for i, parameter := range a.Parameters {
+		parameterPath := path.Root("parameters").AtListIndex(i)
+
+		if !parameter.ID.IsUnknown() {
+			if ids[parameter.ID.ValueString()] {
+				diags.AddAttributeError(parameterPath.AtName("id"), "Duplicate parameter id", fmt.Sprintf("parameter id %s is used more than once", parameter.ID.ValueString()))
+			}
+			ids[parameter.ID.ValueString()] = true
+		}
+
+		if parameter.Type.IsUnknown() {
+			continue
+		}
+
+		isEnum := parameter.Type.ValueString() == string(sdk.AppActionParameterTypeEnum)
+		if isEnum && len(parameter.Options) == 0 {
+			diags.AddAttributeError(parameterPath.AtName("options"), "Missing options", "options need to be set for parameters of type Enum")
+		}
+		if !isEnum && parameter.Options != nil {
+			diags.AddAttributeError(parameterPath.AtName("options"), "Invalid options", "options can only be set for parameters of type Enum")
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,202 @@
+package app_action
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestAppActionDraft_Endpoint(t *testing.T) {
+	action := AppAction{
+		Name:        types.StringValue("Notify"),
+		Description: types.StringNull(),
+		Type:        types.StringValue("endpoint"),
+		Url:         types.StringValue("https://example.com/action"),
+		FunctionID:  types.StringNull(),
+		Category:    types.StringValue("Custom"),
+		Parameters: []Parameter{
+			{
+				ID:          types.StringValue("channel"),
+				Name:        types.StringValue("Channel"),
+				Description: types.StringNull(),
+				Type:        types.StringValue("Enum"),
+				Required:    types.BoolValue(true),
+				Options:     []types.String{types.StringValue("email"), types.StringValue("slack")},
+			},
+		},
+	}
+
+	draft := action.Draft()
+	assert.Equal(t, "Notify", draft.Name)
+	assert.Equal(t, sdk.AppActionDraftType("endpoint"), draft.Type)
+	assert.Equal(t, "https://example.com/action", *draft.Url)
+	assert.Nil(t, draft.Function)
+	assert.Nil(t, draft.Description)
+	require.NotNil(t, draft.Parameters)
+	require.Len(t, *draft.Parameters, 1)
+
+	parameter := (*draft.Parameters)[0]
+	assert.Equal(t, "channel", parameter.Id)
+	assert.Equal(t, sdk.AppActionParameterTypeEnum, parameter.Type)
+	assert.True(t, *parameter.Required)
+	assert.Equal(t, []string{"email", "slack"}, *parameter.Options)
+}
+
+func TestAppActionDraft_Function(t *testing.T) {
+	action := AppAction{
+		Name:       types.StringValue("Translate"),
+		Type:       types.StringValue("function"),
+		Url:        types.StringNull(),
+		FunctionID: types.StringValue("translate"),
+		Category:   types.StringValue("Entries.v1.0"),
+	}
+
+	draft := action.Draft()
+	assert.Nil(t, draft.Url)
+	require.NotNil(t, draft.Function)
+	assert.Equal(t, "Function", draft.Function.Sys.LinkType)
+	assert.Equal(t, "translate", draft.Function.Sys.Id)
+	assert.Nil(t, draft.Parameters)
+}
+
+func TestAppActionImport(t *testing.T) {
+	parameters := []sdk.AppActionParameter{
+		{
+			Id:      "channel",
+			Name:    "Channel",
+			Type:    sdk.AppActionParameterTypeEnum,
+			Options: &[]string{"email", "slack"},
+		},
+	}
+
+	action := AppAction{AppDefinitionID: types.StringValue("app")}
+	action.Import(&sdk.AppAction{
+		Sys:        sdk.SystemPropertiesBase{Id: "action"},
+		Name:       "Notify",
+		Type:       sdk.AppActionTypeEndpoint,
+		Url:        utils.Pointer("https://example.com/action"),
+		Category:   sdk.AppActionCategoryCustom,
+		Parameters: &parameters,
+	})
+
+	assert.Equal(t, "action", action.ID.ValueString())
+	assert.Equal(t, "app", action.AppDefinitionID.ValueString())
+	assert.True(t, action.Description.IsNull())
+	assert.True(t, action.FunctionID.IsNull())
+	assert.Equal(t, "https://example.com/action", action.Url.ValueString())
+	require.Len(t, action.Parameters, 1)
+	assert.False(t, action.Parameters[0].Required.ValueBool())
+	assert.Equal(t, []types.String{types.StringValue("email"), types.StringValue("slack")}, action.Parameters[0].Options)
+
+	action.Import(&sdk.AppAction{
+		Sys:        sdk.SystemPropertiesBase{Id: "action"},
+		Name:       "Notify",
+		Type:       sdk.AppActionTypeEndpoint,
+		Url:        utils.Pointer("https://example.com/action"),
+		Category:   sdk.AppActionCategoryCustom,
+		Parameters: &[]sdk.AppActionParameter{},
+	})
+	assert.Nil(t, action.Parameters)
+}
+
+func TestAppActionValidate(t *testing.T) {
+	valid := AppAction{
+		Type:       types.StringValue("endpoint"),
+		Url:        types.StringValue("https://example.com/action"),
+		FunctionID: types.StringNull(),
+		Category:   types.StringValue("Custom"),
+		Parameters: []Parameter{
+			{ID: types.StringValue("a"), Type: types.StringValue("Symbol")},
+			{ID: types.StringValue("b"), Type: types.StringValue("Enum"), Options: []types.String{types.StringValue("x")}},
+		},
+	}
+	assert.False(t, valid.Validate().HasError())
+
+	tests := []struct {
+		name   string
+		action AppAction
+		path   path.Path
+	}{
+		{
+			name: "endpoint without url",
+			action: AppAction{
+				Type: types.StringValue("endpoint"), Url: types.StringNull(), FunctionID: types.StringNull(),
+				Category: types.StringValue("Custom"),
+			},
+			path: path.Root("url"),
+		},
+		{
+			name: "function with url",
+			action: AppAction{
+				Type: types.StringValue("function"), Url: types.StringValue("https://example.com"), FunctionID: types.StringValue("fn"),
+				Category: types.StringValue("Custom"),
+			},
+			path: path.Root("url"),
+		},
+		{
+			name: "function without function_id",
+			action: AppAction{
+				Type: types.StringValue("function"), Url: types.StringNull(), FunctionID: types.StringNull(),
+				Category: types.StringValue("Custom"),
+			},
+			path: path.Root("function_id"),
+		},
+		{
+			name: "parameters outside custom category",
+			action: AppAction{
+				Type: types.StringValue("endpoint"), Url: types.StringValue("https://example.com"), FunctionID: types.StringNull(),
+				Category:   types.StringValue("Entries.v1.0"),
+				Parameters: []Parameter{{ID: types.StringValue("a"), Type: types.StringValue("Symbol")}},
+			},
+			path: path.Root("parameters"),
+		},
+		{
+			name: "duplicate parameter id",
+			action: AppAction{
+				Type: types.StringValue("endpoint"), Url: types.StringValue("https://example.com"), FunctionID: types.StringNull(),
+				Category: types.StringValue("Custom"),
+				Parameters: []Parameter{
+					{ID: types.StringValue("a"), Type: types.StringValue("Symbol")},
+					{ID: types.StringValue("a"), Type: types.StringValue("Number")},
+				},
+			},
+			path: path.Root("parameters").AtListIndex(1).AtName("id"),
+		},
+		{
+			name: "enum without options",
+			action: AppAction{
+				Type: types.StringValue("endpoint"), Url: types.StringValue("https://example.com"), FunctionID: types.StringNull(),
+				Category:   types.StringValue("Custom"),
+				Parameters: []Parameter{{ID: types.StringValue("a"), Type: types.StringValue("Enum")}},
+			},
+			path: path.Root("parameters").AtListIndex(0).AtName("options"),
+		},
+		{
+			name: "options on boolean",
+			action: AppAction{
+				Type: types.StringValue("endpoint"), Url: types.StringValue("https://example.com"), FunctionID: types.StringNull(),
+				Category: types.StringValue("Custom"),
+				Parameters: []Parameter{
+					{ID: types.StringValue("a"), Type: types.StringValue("Boolean"), Options: []types.String{types.StringValue("x")}},
+				},
+			},
+			path: path.Root("parameters").AtListIndex(0).AtName("options"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := tt.action.Validate()
+			require.Len(t, diags, 1)
+			withPath, ok := diags[0].(interface{ Path() path.Path })
+			require.True(t, ok)
+			assert.Equal(t, tt.path, withPath.Path())
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package app_action
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &appActionResource{}
+	_ resource.ResourceWithConfigure      = &appActionResource{}
+	_ resource.ResourceWithImportState    = &appActionResource{}
+	_ resource.ResourceWithValidateConfig = &appActionResource{}
+)
+
+func NewAppActionResource() resource.Resource {
+	return &appActionResource{}
+}
+
+// appActionResource is the resource implementation.
+type appActionResource struct {
+	client         *sdk.ClientWithResponses
+	organizationId string
+}
+
+func (e *appActionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_app_action"
+}
+
+func (e *appActionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "App actions are operations of an app that can be called by editors and other apps. They are " +
+			"either backed by an endpoint of the app backend or by an app function.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "app action id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_definition_id": schema.StringAttribute{
+				Description: "The ID of the app definition this action belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the app action.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the app action.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the app action, either endpoint or function.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(sdk.AppActionTypeEndpoint), string(sdk.AppActionTypeFunction)),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL that is called when the action is invoked. Required for actions of type endpoint.",
+				Optional:    true,
+			},
+			"function_id": schema.StringAttribute{
+				Description: "ID of the app function that is called when the action is invoked. Required for actions of type function.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Category of the app action, one of Entries.v1.0, Notification.v1.0 or Custom. Only " +
+					"actions in the Custom category can define their own parameters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sdk.AppActionCategoryEntriesV10),
+						string(sdk.AppActionCategoryNotificationV10),
+						string(sdk.AppActionCategoryCustom),
+					),
+				},
+			},
+			"parameters": schema.ListNestedAttribute{
+				Description: "Parameters the action accepts, only for the Custom category.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the parameter.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the parameter.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the parameter.",
+							Optional:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the parameter, one of Symbol, Enum, Number or Boolean.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(sdk.AppActionParameterTypeSymbol),
+									string(sdk.AppActionParameterTypeEnum),
+									string(sdk.AppActionParameterTypeNumber),
+									string(sdk.AppActionParameterTypeBoolean),
+								),
+							},
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether the parameter needs to be provided when calling the action.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+						"options": schema.ListAttribute{
+							Description: "Allowed values, required for parameters of type Enum.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *appActionResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.organizationId = data.OrganizationId
+}
+
+func (e *appActionResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config AppAction
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(config.Validate()...)
+}
+
+func (e *appActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan AppAction
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.CreateAppActionWithResponse(ctx, e.organizationId, plan.AppDefinitionID.ValueString(), *plan.Draft())
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError("Error creating app_action", err.Error())
+		return
+	}
+
+	plan.Import(resp.JSON201)
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state AppAction
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetAppActionWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading app action",
+			fmt.Sprintf("Could not retrieve app action, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *appActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan AppAction
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state AppAction
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.UpdateAppActionWithResponse(ctx, e.organizationId, plan.AppDefinitionID.ValueString(), state.ID.ValueString(), *plan.Draft())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError("Error updating app_action", err.Error())
+		return
+	}
+
+	plan.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state AppAction
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteAppActionWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting app_action",
+			"Could not delete app_action, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *appActionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts := strings.SplitN(request.ID, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: appDefinitionId:appActionId. Got: %q", request.ID),
+		)
+		return
+	}
+
+	resp, err := e.client.GetAppActionWithResponse(ctx, e.organizationId, idParts[0], idParts[1])
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing app action",
+			fmt.Sprintf("Could not import app action: %s", err.Error()),
+		)
+		return
+	}
+
+	state := &AppAction{
+		AppDefinitionID: types.StringValue(idParts[0]),
+	}
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
@@ -0,0 +1,116 @@
+package app_action_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestAppActionResource_Basic(t *testing.T) {
+	resourceName := "contentful_app_action.myaction"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAppActionDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAppActionConfig("Notify"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Notify"),
+					resource.TestCheckResourceAttr(resourceName, "type", "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.1.options.#", "2"),
+				),
+			},
+			{
+				Config: testAppActionConfig("Notify team"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Notify team"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["app_definition_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulAppActionDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_app_action" {
+			continue
+		}
+
+		appDefinitionID := rs.Primary.Attributes["app_definition_id"]
+		resp, err := client.GetAppActionWithResponse(context.Background(), os.Getenv("CONTENTFUL_ORGANIZATION_ID"), appDefinitionID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("app action still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAppActionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "contentful_app_definition" "myapp" {
+  name       = "tf_app_action_test"
+  use_bundle = false
+  src        = "http://localhost:3000"
+  locations  = [{ location = "app-config" }]
+}
+
+resource "contentful_app_action" "myaction" {
+  app_definition_id = contentful_app_definition.myapp.id
+  name              = "%s"
+  description       = "Sends a notification"
+  type              = "endpoint"
+  url               = "https://example.com/actions/notify"
+  category          = "Custom"
+
+  parameters = [
+    {
+      id       = "message"
+      name     = "Message"
+      type     = "Symbol"
+      required = true
+    },
+    {
+      id      = "channel"
+      name    = "Channel"
+      type    = "Enum"
+      options = ["email", "slack"]
+    },
+  ]
+}
+`, name)
+}
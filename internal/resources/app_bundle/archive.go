@@ -0,0 +1,88 @@
+package app_bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// archiveModified is used as modification time of every file in the archive,
+// so the archive only changes when the contents of the source change.
+var archiveModified = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// buildArchive returns the zip archive for the given source. A zip file is
+// used as is, a directory is zipped deterministically: files are added in
+// lexical order with fixed timestamps and permissions.
+func buildArchive(source string) ([]byte, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return os.ReadFile(source)
+	}
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+
+	err = filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", path)
+		}
+
+		name, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(name),
+			Method:   zip.Deflate,
+			Modified: archiveModified,
+		}
+		header.SetMode(0o644)
+
+		w, err := writer.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(w, file)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// archiveHash returns the hex encoded sha256 hash of the archive
+func archiveHash(archive []byte) string {
+	sum := sha256.Sum256(archive)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,65 @@
+package app_bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildArchive_Deterministic(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "assets"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "assets", "app.js"), []byte("console.log(1)"), 0o600))
+
+	first, err := buildArchive(dir)
+	require.NoError(t, err)
+
+	// Touching the files must not change the archive
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "index.html"), later, later))
+
+	second, err := buildArchive(dir)
+	require.NoError(t, err)
+	assert.Equal(t, archiveHash(first), archiveHash(second))
+
+	reader, err := zip.NewReader(bytes.NewReader(first), int64(len(first)))
+	require.NoError(t, err)
+	require.Len(t, reader.File, 2)
+	assert.Equal(t, "assets/app.js", reader.File[0].Name)
+	assert.Equal(t, "index.html", reader.File[1].Name)
+}
+
+func TestBuildArchive_ContentChange(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0o644))
+
+	first, err := buildArchive(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>changed</html>"), 0o644))
+
+	second, err := buildArchive(dir)
+	require.NoError(t, err)
+	assert.NotEqual(t, archiveHash(first), archiveHash(second))
+}
+
+func TestBuildArchive_ZipFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "bundle.zip")
+	require.NoError(t, os.WriteFile(file, []byte("zip contents"), 0o644))
+
+	archive, err := buildArchive(file)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("zip contents"), archive)
+}
+
+func TestBuildArchive_Missing(t *testing.T) {
+	_, err := buildArchive(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
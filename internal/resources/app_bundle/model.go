@@ -0,0 +1,36 @@
+package app_bundle
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// AppBundle is the main resource schema data
+type AppBundle struct {
+	ID              types.String `tfsdk:"id"`
+	AppDefinitionID types.String `tfsdk:"app_definition_id"`
+	Source          types.String `tfsdk:"source"`
+	SourceHash      types.String `tfsdk:"source_hash"`
+	Comment         types.String `tfsdk:"comment"`
+}
+
+func (a *AppBundle) Draft(uploadId string) *sdk.AppBundleDraft {
+	return &sdk.AppBundleDraft{
+		Comment: a.Comment.ValueString(),
+		Upload: sdk.AppBundleDraftUpload{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: "AppUpload",
+				Id:       uploadId,
+			},
+		},
+	}
+}
+
+func Import(a *AppBundle, n *sdk.AppBundle) {
+	a.ID = types.StringValue(n.Sys.Id)
+	if n.Comment != nil {
+		a.Comment = types.StringValue(*n.Comment)
+	}
+}
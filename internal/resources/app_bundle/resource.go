@@ -0,0 +1,231 @@
+package app_bundle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &appBundleResource{}
+	_ resource.ResourceWithConfigure  = &appBundleResource{}
+	_ resource.ResourceWithModifyPlan = &appBundleResource{}
+)
+
+func NewAppBundleResource() resource.Resource {
+	return &appBundleResource{}
+}
+
+// appBundleResource is the resource implementation.
+type appBundleResource struct {
+	client         *sdk.ClientWithResponses
+	clientUpload   *sdk.ClientWithResponses
+	organizationId string
+}
+
+func (e *appBundleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_app_bundle"
+}
+
+func (e *appBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "An app bundle holds the frontend of an app that is hosted by Contentful. The bundle is built " +
+			"from a local directory or zip file, a change of the contents creates a new bundle. Contentful does not " +
+			"allow deleting the bundle that is in use, so use `create_before_destroy` when the bundle is referenced " +
+			"by an app definition.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "app bundle id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_definition_id": schema.StringAttribute{
+				Description: "The ID of the app definition this bundle belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Path to a local directory or zip file with the build of the app frontend. A directory " +
+					"needs to contain an index.html in its root.",
+				Required: true,
+			},
+			"source_hash": schema.StringAttribute{
+				Description: "The sha256 hash of the uploaded zip file, a change creates a new bundle.",
+				Computed:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment of the bundle, shown in the app definition settings.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("Terraform Bundle"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (e *appBundleResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.clientUpload = data.ClientUpload
+	e.organizationId = data.OrganizationId
+}
+
+func (e *appBundleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AppBundle
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.SourceHash = types.StringUnknown()
+	} else {
+		archive, err := buildArchive(plan.Source.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Error reading app bundle source",
+				fmt.Sprintf("Could not read %s: %s", plan.Source.ValueString(), err.Error()),
+			)
+			return
+		}
+		plan.SourceHash = types.StringValue(archiveHash(archive))
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state AppBundle
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.SourceHash.Equal(state.SourceHash) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root("source_hash"))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, plan)...)
+}
+
+func (e *appBundleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan AppBundle
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	archive, err := buildArchive(plan.Source.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error reading app bundle source", err.Error())
+		return
+	}
+
+	upload, err := e.clientUpload.UploadAppWithBodyWithResponse(ctx, e.organizationId, "application/octet-stream", bytes.NewReader(archive))
+	if err := utils.CheckClientResponse(upload, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError("Error uploading app bundle", err.Error())
+		return
+	}
+
+	resp, err := e.client.CreateAppBundleWithResponse(ctx, e.organizationId, plan.AppDefinitionID.ValueString(), *plan.Draft(upload.JSON201.Sys.Id))
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError("Error creating app_bundle", err.Error())
+		return
+	}
+
+	Import(&plan, resp.JSON201)
+	plan.SourceHash = types.StringValue(archiveHash(archive))
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appBundleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state AppBundle
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetAppBundleWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading app bundle",
+			fmt.Sprintf("Could not retrieve app bundle, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+
+	Import(&state, resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *appBundleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// A changed source path with the same contents doesn't need a new bundle,
+	// all other changes require a replacement.
+	var plan AppBundle
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state AppBundle
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appBundleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state AppBundle
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteAppBundleWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting app_bundle",
+			"Could not delete app_bundle, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,79 @@
+package app_bundle_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestAppBundleResource_Create(t *testing.T) {
+	resourceName := "contentful_app_bundle.mybundle"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAppBundleDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAppBundleConfig("Initial bundle"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "Initial bundle"),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile(`^[a-zA-Z0-9-_.]{1,64}$`)),
+					resource.TestMatchResourceAttr(resourceName, "source_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulAppBundleDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_app_bundle" {
+			continue
+		}
+
+		appDefinitionID := rs.Primary.Attributes["app_definition_id"]
+		resp, err := client.GetAppBundleWithResponse(context.Background(), os.Getenv("CONTENTFUL_ORGANIZATION_ID"), appDefinitionID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("app bundle still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAppBundleConfig(comment string) string {
+	return fmt.Sprintf(`
+resource "contentful_app_definition" "myapp" {
+  name       = "tf_app_bundle_test"
+  use_bundle = false
+  src        = "http://localhost:3000"
+  locations  = [{ location = "app-config" }]
+}
+
+resource "contentful_app_bundle" "mybundle" {
+  app_definition_id = contentful_app_definition.myapp.id
+  source            = "test_resources/bundle"
+  comment           = "%s"
+}
+`, comment)
+}
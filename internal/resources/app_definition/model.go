@@ -54,6 +54,12 @@ func (a *AppDefinition) Equal(n *sdk.AppDefinition) bool {
 		return false
 	}
 
+	if a.UseBundle.ValueBool() && !a.BundleId.IsNull() && !a.BundleId.IsUnknown() {
+		if n.Bundle == nil || n.Bundle.Sys == nil || n.Bundle.Sys.Id != a.BundleId.ValueString() {
+			return false
+		}
+	}
+
 	if len(a.Locations) != len(n.Locations) {
 		return false
 	}
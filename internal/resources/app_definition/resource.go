@@ -74,7 +74,10 @@ func (e *appDefinitionResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"bundle_id": schema.StringAttribute{
+				Optional: true,
 				Computed: true,
+				Description: "ID of the app bundle to use when use_bundle is set, for example the id of a " +
+					"contentful_app_bundle. When not set a default bundle is uploaded.",
 				// PlanModifiers: []planmodifier.String{
 				// 	stringplanmodifier.UseStateForUnknown(),
 				// },
@@ -0,0 +1,93 @@
+package app_key
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// AppKey is the main resource schema data
+type AppKey struct {
+	ID              types.String `tfsdk:"id"`
+	AppDefinitionID types.String `tfsdk:"app_definition_id"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+}
+
+// Draft creates the request body for a new app key. When no public key is
+// given Contentful generates a new key pair.
+func (a *AppKey) Draft() (*sdk.AppKeyDraft, error) {
+	if a.PublicKey.IsNull() || a.PublicKey.IsUnknown() {
+		return &sdk.AppKeyDraft{
+			Generate: utils.Pointer(true),
+		}, nil
+	}
+
+	jwk, err := JwkFromPEM(a.PublicKey.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.AppKeyDraft{
+		Jwk: jwk,
+	}, nil
+}
+
+func Import(a *AppKey, n *sdk.AppKey) {
+	a.ID = types.StringValue(n.Sys.Id)
+
+	if a.PublicKey.IsNull() || a.PublicKey.IsUnknown() {
+		a.PublicKey = types.StringNull()
+		if len(n.Jwk.X5c) > 0 {
+			a.PublicKey = types.StringValue(PEMFromJwk(n.Jwk))
+		}
+	}
+
+	if n.Generated != nil {
+		a.PrivateKey = types.StringValue(n.Generated.PrivateKey)
+	} else if a.PrivateKey.IsUnknown() {
+		a.PrivateKey = types.StringNull()
+	}
+}
+
+// JwkFromPEM converts a PEM encoded X.509 certificate into the JSON web key
+// format Contentful expects for app keys.
+func JwkFromPEM(data string) (*sdk.AppKeyJwk, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("public_key must be a PEM encoded certificate")
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	thumbprint := sha1.Sum(block.Bytes)
+	x5t := base64.RawURLEncoding.EncodeToString(thumbprint[:])
+
+	return &sdk.AppKeyJwk{
+		Alg: sdk.RS256,
+		Kty: sdk.RSA,
+		Use: sdk.Sig,
+		X5c: []string{base64.StdEncoding.EncodeToString(block.Bytes)},
+		Kid: x5t,
+		X5t: x5t,
+	}, nil
+}
+
+// PEMFromJwk returns the first certificate of the JSON web key as PEM
+func PEMFromJwk(jwk sdk.AppKeyJwk) string {
+	der, err := base64.StdEncoding.DecodeString(jwk.X5c[0])
+	if err != nil {
+		return jwk.X5c[0]
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,83 @@
+package app_key
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func testCertificate(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-contentful"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestJwkFromPEM(t *testing.T) {
+	certificate := testCertificate(t)
+
+	jwk, err := JwkFromPEM(certificate)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.RS256, jwk.Alg)
+	assert.Equal(t, sdk.RSA, jwk.Kty)
+	assert.Equal(t, sdk.Sig, jwk.Use)
+	assert.Len(t, jwk.X5c, 1)
+	assert.NotEmpty(t, jwk.X5t)
+	assert.Equal(t, jwk.X5t, jwk.Kid)
+
+	assert.Equal(t, certificate, PEMFromJwk(*jwk))
+}
+
+func TestJwkFromPEM_Invalid(t *testing.T) {
+	_, err := JwkFromPEM("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestAppKeyDraft_Generate(t *testing.T) {
+	key := AppKey{PublicKey: types.StringUnknown()}
+
+	draft, err := key.Draft()
+	require.NoError(t, err)
+	assert.True(t, *draft.Generate)
+	assert.Nil(t, draft.Jwk)
+}
+
+func TestAppKeyImport_Generated(t *testing.T) {
+	certificate := testCertificate(t)
+	jwk, err := JwkFromPEM(certificate)
+	require.NoError(t, err)
+
+	key := AppKey{PublicKey: types.StringUnknown(), PrivateKey: types.StringUnknown()}
+	response := &sdk.AppKey{
+		Jwk: *jwk,
+		Sys: sdk.SystemPropertiesBase{Id: jwk.Kid},
+	}
+	response.Generated = &struct {
+		PrivateKey string `json:"privateKey"`
+	}{PrivateKey: "private"}
+
+	Import(&key, response)
+	assert.Equal(t, jwk.Kid, key.ID.ValueString())
+	assert.Equal(t, certificate, key.PublicKey.ValueString())
+	assert.Equal(t, "private", key.PrivateKey.ValueString())
+}
@@ -0,0 +1,198 @@
+package app_key
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &appKeyResource{}
+	_ resource.ResourceWithConfigure   = &appKeyResource{}
+	_ resource.ResourceWithImportState = &appKeyResource{}
+)
+
+func NewAppKeyResource() resource.Resource {
+	return &appKeyResource{}
+}
+
+// appKeyResource is the resource implementation.
+type appKeyResource struct {
+	client         *sdk.ClientWithResponses
+	organizationId string
+}
+
+func (e *appKeyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_app_key"
+}
+
+func (e *appKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "App keys are used by an app backend to request app access tokens. Either provide a public key " +
+			"or let Contentful generate a new key pair. Changing the key replaces it in Contentful.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_definition_id": schema.StringAttribute{
+				Description: "The ID of the app definition this key belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "A PEM encoded X.509 certificate holding the RS256 public key. When not set Contentful " +
+					"generates a new key pair and this contains the generated certificate.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Description: "The PEM encoded private key. Only set when the key pair was generated by Contentful.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (e *appKeyResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.organizationId = data.OrganizationId
+}
+
+func (e *appKeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan AppKey
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	draft, err := plan.Draft()
+	if err != nil {
+		response.Diagnostics.AddError("Error creating app_key", err.Error())
+		return
+	}
+
+	resp, err := e.client.CreateAppKeyWithResponse(ctx, e.organizationId, plan.AppDefinitionID.ValueString(), *draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError("Error creating app_key", err.Error())
+		return
+	}
+
+	Import(&plan, resp.JSON201)
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appKeyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state AppKey
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetAppKeyWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading app key",
+			fmt.Sprintf("Could not retrieve app key, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+
+	Import(&state, resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *appKeyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing
+	// to send to Contentful here.
+	var plan AppKey
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appKeyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state AppKey
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteAppKeyWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting app_key",
+			"Could not delete app_key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *appKeyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts := strings.SplitN(request.ID, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: appDefinitionId:keyId. Got: %q", request.ID),
+		)
+		return
+	}
+
+	state := &AppKey{
+		ID:              types.StringValue(idParts[1]),
+		AppDefinitionID: types.StringValue(idParts[0]),
+		PublicKey:       types.StringNull(),
+		PrivateKey:      types.StringNull(),
+	}
+
+	resp, err := e.client.GetAppKeyWithResponse(ctx, e.organizationId, idParts[0], idParts[1])
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing app key",
+			fmt.Sprintf("Could not import app key: %s", err.Error()),
+		)
+		return
+	}
+
+	Import(state, resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
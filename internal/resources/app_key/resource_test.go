@@ -0,0 +1,75 @@
+package app_key_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestAppKeyResource_Generate(t *testing.T) {
+	resourceName := "contentful_app_key.mykey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAppKeyDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAppKeyConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulAppKeyDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_app_key" {
+			continue
+		}
+
+		appDefinitionID := rs.Primary.Attributes["app_definition_id"]
+		resp, err := client.GetAppKeyWithResponse(context.Background(), os.Getenv("CONTENTFUL_ORGANIZATION_ID"), appDefinitionID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("app key still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAppKeyConfig() string {
+	return `
+resource "contentful_app_definition" "myapp" {
+  name       = "tf_app_key_test"
+  use_bundle = true
+  locations  = [{ location = "entry-field", "field_types" = [{ "type" = "Symbol" }] }]
+}
+
+resource "contentful_app_key" "mykey" {
+  app_definition_id = contentful_app_definition.myapp.id
+}
+`
+}
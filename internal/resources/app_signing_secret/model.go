@@ -0,0 +1,25 @@
+package app_signing_secret
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// AppSigningSecret is the main resource schema data
+type AppSigningSecret struct {
+	ID              types.String `tfsdk:"id"`
+	AppDefinitionID types.String `tfsdk:"app_definition_id"`
+	Value           types.String `tfsdk:"value"`
+	RedactedValue   types.String `tfsdk:"redacted_value"`
+}
+
+func (a *AppSigningSecret) Draft() *sdk.AppSigningSecretDraft {
+	return &sdk.AppSigningSecretDraft{
+		Value: a.Value.ValueString(),
+	}
+}
+
+func Import(a *AppSigningSecret, n *sdk.AppSigningSecret) {
+	a.RedactedValue = types.StringValue(n.RedactedValue)
+}
@@ -0,0 +1,176 @@
+package app_signing_secret
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &appSigningSecretResource{}
+	_ resource.ResourceWithConfigure = &appSigningSecretResource{}
+)
+
+func NewAppSigningSecretResource() resource.Resource {
+	return &appSigningSecretResource{}
+}
+
+// appSigningSecretResource is the resource implementation.
+type appSigningSecretResource struct {
+	client         *sdk.ClientWithResponses
+	organizationId string
+}
+
+func createID(organizationId, appDefinitionId string) string {
+	return organizationId + ":" + appDefinitionId
+}
+
+func (e *appSigningSecretResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_app_signing_secret"
+}
+
+func (e *appSigningSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "The signing secret of an app definition is used to verify that requests to your app backend " +
+			"are sent by Contentful. Changing the secret replaces it in Contentful.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "organization id and app definition id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_definition_id": schema.StringAttribute{
+				Description: "The ID of the app definition this signing secret belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The signing secret, exactly 64 alphanumeric characters.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-zA-Z]{64}$`), "must be exactly 64 alphanumeric characters"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"redacted_value": schema.StringAttribute{
+				Description: "The last four characters of the signing secret, as returned by Contentful.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (e *appSigningSecretResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.organizationId = data.OrganizationId
+}
+
+func (e *appSigningSecretResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan AppSigningSecret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.UpdateAppSigningSecretWithResponse(ctx, e.organizationId, plan.AppDefinitionID.ValueString(), *plan.Draft())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError("Error creating app_signing_secret", err.Error())
+		return
+	}
+
+	Import(&plan, resp.JSON200)
+	plan.ID = types.StringValue(createID(e.organizationId, plan.AppDefinitionID.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appSigningSecretResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state AppSigningSecret
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetAppSigningSecretWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading app signing secret",
+			fmt.Sprintf("Could not retrieve app signing secret, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+
+	// The secret itself is never returned, so a changed redacted value is the
+	// only way to detect that the secret was replaced outside of Terraform.
+	if state.RedactedValue.ValueString() != resp.JSON200.RedactedValue {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	Import(&state, resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *appSigningSecretResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing
+	// to send to Contentful here.
+	var plan AppSigningSecret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *appSigningSecretResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state AppSigningSecret
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteAppSigningSecretWithResponse(ctx, e.organizationId, state.AppDefinitionID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting app_signing_secret",
+			"Could not delete app_signing_secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
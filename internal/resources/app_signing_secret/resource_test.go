@@ -0,0 +1,85 @@
+package app_signing_secret_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestAppSigningSecretResource_Basic(t *testing.T) {
+	resourceName := "contentful_app_signing_secret.mysecret"
+	firstSecret := strings.Repeat("a1B2", 16)
+	secondSecret := strings.Repeat("c3D4", 16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAppSigningSecretDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAppSigningSecretConfig(firstSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", firstSecret),
+					resource.TestCheckResourceAttr(resourceName, "redacted_value", "a1B2"),
+				),
+			},
+			{
+				Config: testAppSigningSecretConfig(secondSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", secondSecret),
+					resource.TestCheckResourceAttr(resourceName, "redacted_value", "c3D4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulAppSigningSecretDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_app_signing_secret" {
+			continue
+		}
+
+		appDefinitionID := rs.Primary.Attributes["app_definition_id"]
+		resp, err := client.GetAppSigningSecretWithResponse(context.Background(), os.Getenv("CONTENTFUL_ORGANIZATION_ID"), appDefinitionID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("app signing secret still exists for app definition: %s", appDefinitionID)
+	}
+
+	return nil
+}
+
+func testAppSigningSecretConfig(value string) string {
+	return fmt.Sprintf(`
+resource "contentful_app_definition" "myapp" {
+  name       = "tf_signing_secret_test"
+  use_bundle = true
+  locations  = [{ location = "entry-field", "field_types" = [{ "type" = "Symbol" }] }]
+}
+
+resource "contentful_app_signing_secret" "mysecret" {
+  app_definition_id = contentful_app_definition.myapp.id
+  value             = "%s"
+}
+`, value)
+}
@@ -1,43 +1,56 @@
 package asset
 
 import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 // Asset is the main resource schema data
 type Asset struct {
-	ID          types.String `tfsdk:"id"`
-	AssetID     types.String `tfsdk:"asset_id"`
-	Version     types.Int64  `tfsdk:"version"`
-	SpaceID     types.String `tfsdk:"space_id"`
-	Environment types.String `tfsdk:"environment"`
-	Fields      *AssetFields `tfsdk:"fields"`
-	Published   types.Bool   `tfsdk:"published"`
-	Archived    types.Bool   `tfsdk:"archived"`
+	ID                  types.String   `tfsdk:"id"`
+	AssetID             types.String   `tfsdk:"asset_id"`
+	Version             types.Int64    `tfsdk:"version"`
+	SpaceID             types.String   `tfsdk:"space_id"`
+	Environment         types.String   `tfsdk:"environment"`
+	Fields              *AssetFields   `tfsdk:"fields"`
+	Tags                types.Set      `tfsdk:"tags"`
+	Concepts            types.Set      `tfsdk:"concepts"`
+	IgnoreUnmanagedTags types.Bool     `tfsdk:"ignore_unmanaged_tags"`
+	Published           types.Bool     `tfsdk:"published"`
+	PublishedLocales    types.Set      `tfsdk:"published_locales"`
+	Archived            types.Bool     `tfsdk:"archived"`
+	DeletionPolicy      types.String   `tfsdk:"deletion_policy"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AssetFields struct {
-	Title       []LocalizedField    `tfsdk:"title"`
-	Description []LocalizedField    `tfsdk:"description"`
-	File        []LocalizedFileItem `tfsdk:"file"`
-}
-
-type LocalizedField struct {
-	Content types.String `tfsdk:"content"`
-	Locale  types.String `tfsdk:"locale"`
+	Title       map[string]types.String `tfsdk:"title"`
+	Description map[string]types.String `tfsdk:"description"`
+	File        map[string]File         `tfsdk:"file"`
 }
 
-type LocalizedFileItem struct {
-	Locale      types.String `tfsdk:"locale"`
-	Upload      types.String `tfsdk:"upload"`
-	URL         types.String `tfsdk:"url"`
-	FileName    types.String `tfsdk:"file_name"`
-	ContentType types.String `tfsdk:"content_type"`
-	FileSize    types.Int64  `tfsdk:"filesize"`
-	ImageWidth  types.Int64  `tfsdk:"image_width"`
-	ImageHeight types.Int64  `tfsdk:"image_height"`
+type File struct {
+	Upload        types.String `tfsdk:"upload"`
+	UploadFrom    types.String `tfsdk:"upload_from"`
+	Source        types.String `tfsdk:"source"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	SourceHash    types.String `tfsdk:"source_hash"`
+	URL           types.String `tfsdk:"url"`
+	FileName      types.String `tfsdk:"file_name"`
+	ContentType   types.String `tfsdk:"content_type"`
+	FileSize      types.Int64  `tfsdk:"filesize"`
+	ImageWidth    types.Int64  `tfsdk:"image_width"`
+	ImageHeight   types.Int64  `tfsdk:"image_height"`
 }
 
 // Import populates the Asset struct from an SDK asset object
@@ -49,35 +62,44 @@ func (a *Asset) Import(asset *sdk.Asset) {
 	a.Environment = types.StringValue(asset.Sys.Environment.Sys.Id)
 	a.Published = types.BoolValue(asset.Sys.PublishedAt != nil)
 	a.Archived = types.BoolValue(asset.Sys.ArchivedAt != nil)
+	if a.PublishedLocales.IsNull() {
+		a.PublishedLocales = types.SetNull(types.StringType)
+	} else {
+		a.PublishedLocales = utils.PublishedLocales(asset.Sys.FieldStatus)
+	}
 	a.Version = types.Int64Value(asset.Sys.Version)
+	a.Tags = utils.MetadataTags(asset.Metadata)
+	a.Concepts = utils.MetadataConcepts(asset.Metadata)
 
-	// Import fields
-	a.Fields = &AssetFields{
-		Title:       []LocalizedField{},
-		Description: []LocalizedField{},
-		File:        []LocalizedFileItem{},
+	// Import fields, the maps are left nil when Contentful has no value for
+	// any locale so they match an omitted attribute. An empty map that is set
+	// in the configuration is kept, as Contentful doesn't return it.
+	previous := a.Fields
+	a.Fields = &AssetFields{}
+	if previous != nil {
+		a.Fields.Title = emptyMap(previous.Title)
+		a.Fields.Description = emptyMap(previous.Description)
 	}
 
 	// Title
 	for locale, content := range asset.Fields.Title {
-		a.Fields.Title = append(a.Fields.Title, LocalizedField{
-			Content: types.StringValue(content),
-			Locale:  types.StringValue(locale),
-		})
+		if a.Fields.Title == nil {
+			a.Fields.Title = map[string]types.String{}
+		}
+		a.Fields.Title[locale] = types.StringValue(content)
 	}
 
 	// Description
 	for locale, content := range asset.Fields.Description {
-		a.Fields.Description = append(a.Fields.Description, LocalizedField{
-			Content: types.StringValue(content),
-			Locale:  types.StringValue(locale),
-		})
+		if a.Fields.Description == nil {
+			a.Fields.Description = map[string]types.String{}
+		}
+		a.Fields.Description[locale] = types.StringValue(content)
 	}
 
 	// File
 	for locale, file := range asset.Fields.File {
-		fileItem := LocalizedFileItem{
-			Locale:      types.StringValue(locale),
+		fileItem := File{
 			ContentType: types.StringValue(file.ContentType),
 			Upload:      types.StringPointerValue(file.Upload),
 			URL:         types.StringPointerValue(file.Url),
@@ -92,54 +114,223 @@ func (a *Asset) Import(asset *sdk.Asset) {
 			}
 		}
 
-		// Add to files collection
-		a.Fields.File = append(a.Fields.File, fileItem)
+		if a.Fields.File == nil {
+			a.Fields.File = map[string]File{}
+		}
+		a.Fields.File[locale] = fileItem
 	}
 }
 
+// emptyMap returns an empty map when the map is set but has no values, and nil
+// otherwise.
+func emptyMap(values map[string]types.String) map[string]types.String {
+	if values != nil && len(values) == 0 {
+		return map[string]types.String{}
+	}
+	return nil
+}
+
+// CopyInputValues copies the file values that are only known to the
+// configuration, Contentful drops them once the file is processed. The tags
+// that are not managed are left out when they are ignored.
 func (a *Asset) CopyInputValues(plan *Asset) {
-	uploads := map[string]string{}
-	for _, file := range plan.Fields.File {
-		if !file.Upload.IsNull() {
-			uploads[file.Locale.ValueString()] = file.Upload.ValueString()
+	a.IgnoreUnmanagedTags = plan.IgnoreUnmanagedTags
+	a.DeletionPolicy = plan.DeletionPolicy
+	if plan.IgnoreUnmanagedTags.ValueBool() {
+		a.Tags = utils.ManagedTags(a.Tags, plan.Tags)
+	}
+
+	if a.Fields == nil {
+		return
+	}
+
+	for locale, file := range a.Fields.File {
+		input := plan.file(locale)
+		if input == nil {
+			continue
+		}
+
+		file.Upload = input.Upload
+		file.UploadFrom = input.UploadFrom
+		file.Source = input.Source
+		file.Content = input.Content
+		file.ContentBase64 = input.ContentBase64
+		file.SourceHash = input.SourceHash
+		if !input.ContentType.IsNull() && !input.ContentType.IsUnknown() {
+			file.ContentType = input.ContentType
+		}
+		a.Fields.File[locale] = file
+	}
+}
+
+func (a *Asset) file(locale string) *File {
+	if a == nil || a.Fields == nil {
+		return nil
+	}
+
+	if file, ok := a.Fields.File[locale]; ok {
+		return &file
+	}
+	return nil
+}
+
+// PlanFiles computes the hash of the local source files and keeps the
+// processed file of every locale that did not change, so only the changed
+// locales are uploaded and processed again.
+func (a *Asset) PlanFiles(config *Asset, state *Asset) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for locale, item := range a.Fields.File {
+		input := config.Fields.File[locale]
+
+		hash, err := input.sourceHash()
+		if err != nil {
+			detail := fmt.Sprintf("Could not read %s: %s", input.Source.ValueString(), err.Error())
+			if input.Source.IsNull() {
+				detail = "Could not decode content_base64: " + err.Error()
+			}
+			diags.AddAttributeError(
+				filePath(locale).AtName(input.sourceAttribute()),
+				"Error reading asset file source",
+				detail,
+			)
+			continue
+		}
+		item.SourceHash = hash
+
+		if input.UploadFrom.IsNull() {
+			if input.hasLocalSource() {
+				item.UploadFrom = types.StringUnknown()
+			} else {
+				item.UploadFrom = types.StringNull()
+			}
+		}
+
+		previous := state.file(locale)
+		unchanged := previous != nil && previous.URL.ValueString() != "" &&
+			item.Upload.Equal(previous.Upload) &&
+			item.FileName.Equal(previous.FileName) &&
+			item.SourceHash.Equal(previous.SourceHash) &&
+			(input.UploadFrom.IsNull() || item.UploadFrom.Equal(previous.UploadFrom)) &&
+			(input.ContentType.IsNull() || item.ContentType.Equal(previous.ContentType))
+
+		if unchanged {
+			if input.UploadFrom.IsNull() {
+				item.UploadFrom = previous.UploadFrom
+			}
+			if input.ContentType.IsNull() {
+				item.ContentType = previous.ContentType
+			}
+			item.URL = previous.URL
+			item.FileSize = previous.FileSize
+			item.ImageWidth = previous.ImageWidth
+			item.ImageHeight = previous.ImageHeight
 		}
+
+		a.Fields.File[locale] = item
 	}
-	contentTypes := map[string]string{}
-	for _, file := range plan.Fields.File {
-		if !file.ContentType.IsNull() {
-			contentTypes[file.Locale.ValueString()] = file.ContentType.ValueString()
+
+	return diags
+}
+
+// hasLocalSource reports whether the provider uploads the file itself, either
+// from a local file or from the inline content.
+func (f *File) hasLocalSource() bool {
+	return !f.Source.IsNull() || !f.Content.IsNull() || !f.ContentBase64.IsNull()
+}
+
+// sourceAttribute returns the name of the attribute the file is uploaded from.
+func (f *File) sourceAttribute() string {
+	switch {
+	case !f.Content.IsNull():
+		return "content"
+	case !f.ContentBase64.IsNull():
+		return "content_base64"
+	default:
+		return "source"
+	}
+}
+
+// inlineContent returns the bytes of the content or the decoded
+// content_base64 of the file.
+func (f *File) inlineContent() ([]byte, error) {
+	if !f.ContentBase64.IsNull() {
+		return base64.StdEncoding.DecodeString(f.ContentBase64.ValueString())
+	}
+	return []byte(f.Content.ValueString()), nil
+}
+
+// sourceHash returns the sha256 of the local file or the inline content, which
+// is unknown as long as the source isn't known and null for a file that is
+// not uploaded by the provider.
+func (f *File) sourceHash() (types.String, error) {
+	switch {
+	case !f.hasLocalSource():
+		return types.StringNull(), nil
+	case f.Source.IsUnknown() || f.Content.IsUnknown() || f.ContentBase64.IsUnknown():
+		return types.StringUnknown(), nil
+	case !f.Source.IsNull():
+		hash, err := utils.HashFile(f.Source.ValueString())
+		if err != nil {
+			return types.StringNull(), err
 		}
+		return types.StringValue(hash), nil
+	default:
+		content, err := f.inlineContent()
+		if err != nil {
+			return types.StringNull(), err
+		}
+		return types.StringValue(utils.HashContent(content)), nil
 	}
+}
 
-	for i, file := range a.Fields.File {
-		a.Fields.File[i].Upload = types.StringValue(uploads[file.Locale.ValueString()])
+// filePath returns the path of the file of the given locale, used to report
+// errors on the file that caused them.
+func filePath(locale string) path.Path {
+	return path.Root("fields").AtName("file").AtMapKey(locale)
+}
+
+// UnprocessedLocales returns the locales of which Contentful has not yet
+// generated the file URL.
+func UnprocessedLocales(asset *sdk.Asset, locales []string) []string {
+	var result []string
+	for _, locale := range locales {
+		file, ok := asset.Fields.File[locale]
+		if !ok || file.Url == nil || *file.Url == "" {
+			result = append(result, locale)
+		}
 	}
+	return result
+}
 
-	for i, file := range a.Fields.File {
-		a.Fields.File[i].ContentType = types.StringValue(contentTypes[file.Locale.ValueString()])
+// PendingLocales returns the locales of which the file still needs to be
+// processed by Contentful.
+func (a *Asset) PendingLocales() []string {
+	var locales []string
+	for locale, file := range a.Fields.File {
+		if file.URL.IsNull() || file.URL.IsUnknown() || file.URL.ValueString() == "" {
+			locales = append(locales, locale)
+		}
 	}
+	slices.Sort(locales)
+	return locales
 }
 
 // DraftForCreate creates an AssetCreate object for the API
 func (a *Asset) DraftForCreate() *sdk.AssetCreate {
 	localizedTitle := map[string]string{}
-	for _, item := range a.Fields.Title {
-		localizedTitle[item.Locale.ValueString()] = item.Content.ValueString()
+	for locale, content := range a.Fields.Title {
+		localizedTitle[locale] = content.ValueString()
 	}
 
 	localizedDescription := map[string]string{}
-	for _, item := range a.Fields.Description {
-		localizedDescription[item.Locale.ValueString()] = item.Content.ValueString()
+	for locale, content := range a.Fields.Description {
+		localizedDescription[locale] = content.ValueString()
 	}
 
 	fileData := map[string]sdk.AssetFile{}
-	for _, item := range a.Fields.File {
-		key := item.Locale.ValueString()
-		fileData[key] = sdk.AssetFile{
-			Upload:      item.Upload.ValueString(),
-			FileName:    item.FileName.ValueString(),
-			ContentType: item.ContentType.ValueString(),
-		}
+	for locale, item := range a.Fields.File {
+		fileData[locale] = item.Draft()
 	}
 
 	return &sdk.AssetCreate{
@@ -148,5 +339,41 @@ func (a *Asset) DraftForCreate() *sdk.AssetCreate {
 			Description: localizedDescription,
 			File:        fileData,
 		},
+		Metadata: utils.MetadataDraft(a.Tags, a.Concepts),
+	}
+}
+
+// Draft creates the file for a single locale. A file that was processed before
+// is sent as is, so Contentful doesn't need to process it again.
+func (f *File) Draft() sdk.AssetFile {
+	file := sdk.AssetFile{
+		FileName:    f.FileName.ValueString(),
+		ContentType: f.ContentType.ValueString(),
+	}
+
+	switch {
+	case !f.URL.IsNull() && !f.URL.IsUnknown() && f.URL.ValueString() != "":
+		file.Url = f.URL.ValueStringPointer()
+		file.Details = &sdk.AssetFileDetails{
+			Size: f.FileSize.ValueInt64Pointer(),
+		}
+		if !f.ImageWidth.IsNull() || !f.ImageHeight.IsNull() {
+			file.Details.Image = &sdk.AssetFileImageDetails{
+				Width:  f.ImageWidth.ValueInt64Pointer(),
+				Height: f.ImageHeight.ValueInt64Pointer(),
+			}
+		}
+	case !f.UploadFrom.IsNull() && !f.UploadFrom.IsUnknown():
+		file.UploadFrom = &sdk.SystemPropertiesReference{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: "Upload",
+				Id:       f.UploadFrom.ValueString(),
+			},
+		}
+	default:
+		file.Upload = f.Upload.ValueStringPointer()
 	}
+
+	return file
 }
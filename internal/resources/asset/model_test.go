@@ -0,0 +1,354 @@
+package asset
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func testFileItem() File {
+	return File{
+		Upload:        types.StringNull(),
+		UploadFrom:    types.StringNull(),
+		Source:        types.StringNull(),
+		Content:       types.StringNull(),
+		ContentBase64: types.StringNull(),
+		SourceHash:    types.StringNull(),
+		URL:           types.StringUnknown(),
+		FileName:      types.StringValue("logo.svg"),
+		ContentType:   types.StringValue("image/svg+xml"),
+		FileSize:      types.Int64Unknown(),
+		ImageWidth:    types.Int64Unknown(),
+		ImageHeight:   types.Int64Unknown(),
+	}
+}
+
+func processedFileItem(item File, url string) File {
+	item.URL = types.StringValue(url)
+	item.FileSize = types.Int64Value(120)
+	item.ImageWidth = types.Int64Value(64)
+	item.ImageHeight = types.Int64Value(64)
+	return item
+}
+
+func TestAssetPlanFiles_Source(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "logo.svg")
+	require.NoError(t, os.WriteFile(source, []byte("<svg/>"), 0o644))
+	hash, err := utils.HashFile(source)
+	require.NoError(t, err)
+
+	item := testFileItem()
+	item.Source = types.StringValue(source)
+
+	input := item
+	input.URL = types.StringNull()
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": input, "de-DE": input}}}
+
+	previousEnglish := item
+	previousEnglish.SourceHash = types.StringValue(hash)
+	previousEnglish.UploadFrom = types.StringValue("upload-en")
+	previousGerman := item
+	previousGerman.SourceHash = types.StringValue("outdated")
+	previousGerman.UploadFrom = types.StringValue("upload-de")
+	state := &Asset{Fields: &AssetFields{File: map[string]File{
+		"en-US": processedFileItem(previousEnglish, "//images.ctfassets.net/en.svg"),
+		"de-DE": processedFileItem(previousGerman, "//images.ctfassets.net/de.svg"),
+	}}}
+
+	item.UploadFrom = types.StringUnknown()
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item, "de-DE": item}}}
+
+	diags := plan.PlanFiles(config, state)
+	require.False(t, diags.HasError())
+
+	// The unchanged locale keeps its processed file
+	english := plan.Fields.File["en-US"]
+	assert.Equal(t, hash, english.SourceHash.ValueString())
+	assert.Equal(t, "upload-en", english.UploadFrom.ValueString())
+	assert.Equal(t, "//images.ctfassets.net/en.svg", english.URL.ValueString())
+	assert.Equal(t, int64(64), english.ImageWidth.ValueInt64())
+
+	// The changed locale is uploaded and processed again
+	german := plan.Fields.File["de-DE"]
+	assert.Equal(t, hash, german.SourceHash.ValueString())
+	assert.True(t, german.UploadFrom.IsUnknown())
+	assert.True(t, german.URL.IsUnknown())
+
+	assert.Equal(t, []string{"de-DE"}, plan.PendingLocales())
+}
+
+func TestAssetPlanFiles_MissingSource(t *testing.T) {
+	item := testFileItem()
+	item.Source = types.StringValue(filepath.Join(t.TempDir(), "missing.svg"))
+
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+
+	diags := plan.PlanFiles(config, nil)
+	require.True(t, diags.HasError())
+	assert.Equal(t, path.Root("fields").AtName("file").AtMapKey("en-US").AtName("source"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestAssetPlanFiles_Content(t *testing.T) {
+	hash := utils.HashContent([]byte("<svg/>"))
+
+	text := testFileItem()
+	text.Content = types.StringValue("<svg/>")
+	encoded := testFileItem()
+	encoded.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString([]byte("<svg/>")))
+
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": text, "de-DE": encoded}}}
+
+	previous := testFileItem()
+	previous.Content = types.StringValue("<svg/>")
+	previous.SourceHash = types.StringValue(hash)
+	previous.UploadFrom = types.StringValue("upload-en")
+	state := &Asset{Fields: &AssetFields{File: map[string]File{
+		"en-US": processedFileItem(previous, "//images.ctfassets.net/en.svg"),
+	}}}
+
+	text.UploadFrom = types.StringUnknown()
+	encoded.UploadFrom = types.StringUnknown()
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": text, "de-DE": encoded}}}
+
+	diags := plan.PlanFiles(config, state)
+	require.False(t, diags.HasError())
+
+	// Both encodings of the same content have the same hash
+	english := plan.Fields.File["en-US"]
+	assert.Equal(t, hash, english.SourceHash.ValueString())
+	assert.Equal(t, "upload-en", english.UploadFrom.ValueString())
+	assert.Equal(t, "//images.ctfassets.net/en.svg", english.URL.ValueString())
+
+	german := plan.Fields.File["de-DE"]
+	assert.Equal(t, hash, german.SourceHash.ValueString())
+	assert.True(t, german.UploadFrom.IsUnknown())
+
+	assert.Equal(t, []string{"de-DE"}, plan.PendingLocales())
+}
+
+func TestAssetPlanFiles_UnknownContent(t *testing.T) {
+	item := testFileItem()
+	item.Content = types.StringUnknown()
+
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+
+	diags := plan.PlanFiles(config, nil)
+	require.False(t, diags.HasError())
+	assert.True(t, plan.Fields.File["en-US"].SourceHash.IsUnknown())
+	assert.True(t, plan.Fields.File["en-US"].UploadFrom.IsUnknown())
+}
+
+func TestAssetPlanFiles_InvalidBase64(t *testing.T) {
+	item := testFileItem()
+	item.ContentBase64 = types.StringValue("not base64!")
+
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+
+	diags := plan.PlanFiles(config, nil)
+	require.True(t, diags.HasError())
+	assert.Equal(t, path.Root("fields").AtName("file").AtMapKey("en-US").AtName("content_base64"), diags[0].(diag.DiagnosticWithPath).Path())
+}
+
+func TestAssetPlanFiles_Upload(t *testing.T) {
+	item := testFileItem()
+	item.Upload = types.StringValue("https://example.com/logo.svg")
+
+	config := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+	item.UploadFrom = types.StringUnknown()
+	item.SourceHash = types.StringUnknown()
+	plan := &Asset{Fields: &AssetFields{File: map[string]File{"en-US": item}}}
+
+	diags := plan.PlanFiles(config, nil)
+	require.False(t, diags.HasError())
+	assert.True(t, plan.Fields.File["en-US"].UploadFrom.IsNull())
+	assert.True(t, plan.Fields.File["en-US"].SourceHash.IsNull())
+	assert.Equal(t, []string{"en-US"}, plan.PendingLocales())
+}
+
+func TestFileDraft(t *testing.T) {
+	item := testFileItem()
+	item.Upload = types.StringValue("https://example.com/logo.svg")
+	draft := item.Draft()
+	assert.Equal(t, "https://example.com/logo.svg", *draft.Upload)
+	assert.Nil(t, draft.UploadFrom)
+	assert.Nil(t, draft.Url)
+
+	item = testFileItem()
+	item.UploadFrom = types.StringValue("upload")
+	draft = item.Draft()
+	assert.Nil(t, draft.Upload)
+	require.NotNil(t, draft.UploadFrom)
+	assert.Equal(t, "Upload", draft.UploadFrom.Sys.LinkType)
+	assert.Equal(t, "upload", draft.UploadFrom.Sys.Id)
+
+	item = processedFileItem(item, "//images.ctfassets.net/logo.svg")
+	draft = item.Draft()
+	assert.Nil(t, draft.UploadFrom)
+	assert.Equal(t, "//images.ctfassets.net/logo.svg", *draft.Url)
+	assert.Equal(t, int64(120), *draft.Details.Size)
+	assert.Equal(t, int64(64), *draft.Details.Image.Width)
+}
+
+func TestAssetCopyInputValues(t *testing.T) {
+	item := testFileItem()
+	item.Source = types.StringValue("logo.svg")
+	item.SourceHash = types.StringValue("hash")
+	item.UploadFrom = types.StringValue("upload")
+	inline := testFileItem()
+	inline.Content = types.StringValue("<svg/>")
+	plan := &Asset{
+		DeletionPolicy: types.StringValue("archive"),
+		Fields:         &AssetFields{File: map[string]File{"en-US": item, "de-DE": inline}},
+	}
+
+	state := &Asset{Fields: &AssetFields{File: map[string]File{
+		"en-US": processedFileItem(File{
+			FileName:    types.StringValue("logo.svg"),
+			ContentType: types.StringValue("image/svg+xml"),
+		}, "//images.ctfassets.net/logo.svg"),
+		"de-DE": processedFileItem(File{
+			FileName:    types.StringValue("logo.svg"),
+			ContentType: types.StringValue("image/svg+xml"),
+		}, "//images.ctfassets.net/inline.svg"),
+	}}}
+
+	state.CopyInputValues(plan)
+	assert.Equal(t, "archive", state.DeletionPolicy.ValueString())
+	file := state.Fields.File["en-US"]
+	assert.True(t, file.Upload.IsNull())
+	assert.Equal(t, "logo.svg", file.Source.ValueString())
+	assert.Equal(t, "hash", file.SourceHash.ValueString())
+	assert.Equal(t, "upload", file.UploadFrom.ValueString())
+	assert.Equal(t, "//images.ctfassets.net/logo.svg", file.URL.ValueString())
+	assert.Equal(t, "<svg/>", state.Fields.File["de-DE"].Content.ValueString())
+}
+
+func TestAssetImport_LocaleKeyed(t *testing.T) {
+	asset := &sdk.Asset{}
+	asset.Sys.Id = "logo"
+	asset.Sys.Space = sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "space"}}
+	asset.Sys.Environment = &sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "master"}}
+	asset.Fields.Title = map[string]string{"en-US": "Logo", "de-DE": "Logo DE", "nl-NL": "Logo NL"}
+
+	var result Asset
+	result.Import(asset)
+	assert.Equal(t, map[string]types.String{
+		"en-US": types.StringValue("Logo"),
+		"de-DE": types.StringValue("Logo DE"),
+		"nl-NL": types.StringValue("Logo NL"),
+	}, result.Fields.Title)
+
+	// Fields without any value are left null, so they match an omitted attribute
+	assert.Nil(t, result.Fields.Description)
+	assert.Nil(t, result.Fields.File)
+}
+
+func TestAssetImport_EmptyMaps(t *testing.T) {
+	asset := &sdk.Asset{}
+	asset.Sys.Id = "logo"
+	asset.Sys.Space = sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "space"}}
+	asset.Sys.Environment = &sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "master"}}
+	asset.Fields.Title = map[string]string{"en-US": "Logo"}
+
+	// An empty description in the configuration is kept, Contentful returns
+	// no locales for it
+	result := Asset{Fields: &AssetFields{
+		Title:       map[string]types.String{},
+		Description: map[string]types.String{},
+	}}
+	result.Import(asset)
+	assert.Equal(t, map[string]types.String{"en-US": types.StringValue("Logo")}, result.Fields.Title)
+	assert.NotNil(t, result.Fields.Description)
+	assert.Empty(t, result.Fields.Description)
+
+	// A description with values that are removed in Contentful is left null
+	result.Fields.Description = map[string]types.String{"en-US": types.StringValue("Logo")}
+	result.Import(asset)
+	assert.Nil(t, result.Fields.Description)
+}
+
+func TestAssetImport_PublishedLocales(t *testing.T) {
+	asset := &sdk.Asset{}
+	asset.Sys.Id = "logo"
+	asset.Sys.Space = sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "space"}}
+	asset.Sys.Environment = &sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "master"}}
+	asset.Sys.FieldStatus = &map[string]map[string]string{"*": {"en-US": "published", "de-DE": "draft"}}
+
+	var result Asset
+	result.Import(asset)
+	assert.True(t, result.PublishedLocales.IsNull())
+
+	result.PublishedLocales = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("de-DE")})
+	result.Import(asset)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("en-US")}), result.PublishedLocales)
+}
+
+func TestUnprocessedLocales(t *testing.T) {
+	asset := &sdk.Asset{}
+	asset.Fields.File = map[string]struct {
+		ContentType string                `json:"contentType"`
+		Details     *sdk.AssetFileDetails `json:"details,omitempty"`
+		FileName    string                `json:"fileName"`
+		Upload      *string               `json:"upload,omitempty"`
+		Url         *string               `json:"url,omitempty"`
+	}{
+		"en-US": {FileName: "logo.svg", Url: utils.Pointer("//images.ctfassets.net/logo.svg")},
+		"de-DE": {FileName: "logo.svg", Upload: utils.Pointer("https://example.com/logo.svg")},
+	}
+
+	assert.Equal(t, []string{"de-DE", "nl-NL"}, UnprocessedLocales(asset, []string{"en-US", "de-DE", "nl-NL"}))
+	assert.Empty(t, UnprocessedLocales(asset, []string{"en-US"}))
+}
+
+func TestAssetDraftForCreate_MergeUnmanagedTags(t *testing.T) {
+	plan := &Asset{
+		Fields:              &AssetFields{},
+		Tags:                types.SetValueMust(types.StringType, []attr.Value{types.StringValue("campaign")}),
+		Concepts:            types.SetValueMust(types.StringType, []attr.Value{}),
+		IgnoreUnmanagedTags: types.BoolValue(true),
+	}
+	previous := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("campaign"),
+		types.StringValue("removed"),
+	})
+	current := &sdk.ContentMetadata{
+		Tags: &[]sdk.SystemPropertiesReference{
+			{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "campaign"}},
+			{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "removed"}},
+			{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "added-by-editor"}},
+		},
+	}
+
+	draft := plan.DraftForCreate()
+	utils.MergeUnmanagedTags(draft.Metadata, previous, current)
+
+	// The tag removed from the configuration is dropped, the tag of the editor is kept
+	assert.Equal(t, []sdk.SystemPropertiesReference{
+		{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "campaign"}},
+		{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "added-by-editor"}},
+	}, *draft.Metadata.Tags)
+	assert.Empty(t, *draft.Metadata.Concepts)
+
+	// Reading the result back only shows the managed tags
+	asset := &sdk.Asset{Metadata: &sdk.ContentMetadata{Tags: draft.Metadata.Tags}}
+	asset.Sys.Environment = &sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "master"}}
+	state := &Asset{}
+	state.Import(asset)
+	state.CopyInputValues(plan)
+	assert.Equal(t, plan.Tags, state.Tags)
+}
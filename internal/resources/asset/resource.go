@@ -2,17 +2,27 @@ package asset
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
@@ -21,27 +31,39 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &assetResource{}
-	_ resource.ResourceWithConfigure   = &assetResource{}
-	_ resource.ResourceWithImportState = &assetResource{}
+	_ resource.Resource                   = &assetResource{}
+	_ resource.ResourceWithConfigure      = &assetResource{}
+	_ resource.ResourceWithImportState    = &assetResource{}
+	_ resource.ResourceWithModifyPlan     = &assetResource{}
+	_ resource.ResourceWithUpgradeState   = &assetResource{}
+	_ resource.ResourceWithValidateConfig = &assetResource{}
 )
 
+// defaultProcessingTimeout is used when no create or update timeout is
+// configured.
+const defaultProcessingTimeout = 5 * time.Minute
+
+var errAssetProcessing = errors.New("asset is still being processed")
+
 func NewAssetResource() resource.Resource {
 	return &assetResource{}
 }
 
 // assetResource is the resource implementation.
 type assetResource struct {
-	client *sdk.ClientWithResponses
+	client       *sdk.ClientWithResponses
+	clientUpload *sdk.ClientWithResponses
 }
 
 func (e *assetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_asset"
 }
 
-func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (e *assetResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		Description: "Contentful Asset represents a media file in Contentful.",
+		Version: 1,
+		Description: "Contentful Asset represents a media file in Contentful. After a file changed the provider waits " +
+			"until Contentful processed it, the create and update timeouts limit how long it waits.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -79,56 +101,104 @@ func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Whether the asset is published",
 			},
+			"published_locales": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only publish these locales and unpublish the others, which needs locale based publishing in the space. " +
+					"When not set, all locales are published together",
+			},
 			"archived": schema.BoolAttribute{
 				Required:    true,
 				Description: "Whether the asset is archived",
 			},
+			"deletion_policy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(utils.DeletionPolicyDelete),
+				Description: "What happens to the asset when the resource is destroyed: delete it, archive it, unpublish it or " +
+					"retain it unchanged. Defaults to delete",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.DeletionPolicies...),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the tags of the asset. When not set, the tags that are managed in the web app are kept",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"concepts": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the taxonomy concepts of the asset. When not set, the concepts that are managed in the web app are kept",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_unmanaged_tags": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only manage the tags that are set in tags, tags that are added in the web app are kept and don't show up in the plan",
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 			"fields": schema.SingleNestedBlock{
-				Description: "Asset fields",
-				Blocks: map[string]schema.Block{
-					"title": schema.ListNestedBlock{
-						Description: "Asset title in different locales",
-						NestedObject: schema.NestedBlockObject{
+				Description: "Asset fields, the values are keyed by locale code",
+				Attributes: map[string]schema.Attribute{
+					"title": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Asset title by locale",
+					},
+					"description": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Asset description by locale",
+					},
+					"file": schema.MapNestedAttribute{
+						Optional:    true,
+						Description: "Asset file by locale",
+						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"content": schema.StringAttribute{
-									Required:    true,
-									Description: "The title content",
+								"upload": schema.StringAttribute{
+									Optional:    true,
+									Description: "Publicly reachable URL of the file, exactly one of upload, upload_from, source, content and content_base64 needs to be set",
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(
+											path.MatchRelative().AtParent().AtName("upload_from"),
+											path.MatchRelative().AtParent().AtName("source"),
+											path.MatchRelative().AtParent().AtName("content"),
+											path.MatchRelative().AtParent().AtName("content_base64"),
+										),
+									},
 								},
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
+								"upload_from": schema.StringAttribute{
+									Optional:    true,
+									Computed:    true,
+									Description: "ID of an upload to link into the file, for example of a contentful_upload. Set by the provider when source, content or content_base64 is used",
 								},
-							},
-						},
-					},
-					"description": schema.ListNestedBlock{
-						Description: "Asset description in different locales",
-						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"content": schema.StringAttribute{
-									Required:    true,
-									Description: "The description content",
+								"source": schema.StringAttribute{
+									Optional:    true,
+									Description: "Path to a local file that is uploaded to Contentful, only a change of the contents uploads and processes the file again",
 								},
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
+								"content": schema.StringAttribute{
+									Optional:    true,
+									Description: "Text content of the file, for example the result of templatefile, that is uploaded to Contentful. Only a change of the content uploads and processes the file again",
 								},
-							},
-						},
-					},
-					"file": schema.ListNestedBlock{
-						Description: "Asset file information",
-						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
+								"content_base64": schema.StringAttribute{
+									Optional:    true,
+									Description: "Base64 encoded content of the file, for binary files generated in the configuration. Only a change of the content uploads and processes the file again",
 								},
-								"upload": schema.StringAttribute{
-									Required:    true,
-									Description: "Upload URL or ID",
+								"source_hash": schema.StringAttribute{
+									Computed:    true,
+									Description: "The sha256 hash of the source file or the inline content",
 								},
 								"url": schema.StringAttribute{
 									Computed:    true,
@@ -171,6 +241,65 @@ func (e *assetResource) Configure(_ context.Context, request resource.ConfigureR
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.clientUpload = data.ClientUpload
+}
+
+func (e *assetResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var published types.Bool
+	var publishedLocales types.Set
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("published"), &published)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("published_locales"), &publishedLocales)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !publishedLocales.IsNull() && !published.IsUnknown() && !published.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("published_locales"),
+			"Invalid published locales",
+			"published_locales can only be set when published is true",
+		)
+	}
+}
+
+func (e *assetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Show what happens to the content when the asset is destroyed
+	if request.Plan.Raw.IsNull() {
+		var state Asset
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		summary, detail, ok := utils.DeletionWarning("Asset", state.ID.ValueString(), state.DeletionPolicy.ValueString(), state.Published.ValueBool())
+		if ok {
+			response.Diagnostics.AddWarning(summary, detail)
+		}
+		return
+	}
+
+	var plan, config Asset
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() || plan.Fields == nil || config.Fields == nil {
+		return
+	}
+
+	var state *Asset
+	if !request.State.Raw.IsNull() {
+		state = &Asset{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(plan.PlanFiles(&config, state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, plan)...)
 }
 
 func (e *assetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -182,6 +311,11 @@ func (e *assetResource) Create(ctx context.Context, request resource.CreateReque
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Asset plan:\n %v", spew.Sdump(plan)))
 
+	response.Diagnostics.Append(e.uploadSources(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the asset
 	draft := plan.DraftForCreate()
 	resp, err := e.client.UpdateAssetWithResponse(
@@ -203,11 +337,20 @@ func (e *assetResource) Create(ctx context.Context, request resource.CreateReque
 
 	state := plan
 	state.Import(asset)
+	state.CopyInputValues(&plan)
 
 	tflog.Debug(ctx, fmt.Sprintf("Asset created with ID: %s\n %v", state.ID.ValueString(), spew.Sdump(state)))
 
-	if diag := e.processAsset(ctx, &state); diag != nil {
-		response.Diagnostics.Append(diag)
+	timeout, diags := plan.Timeouts.Create(ctx, defaultProcessingTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(e.processAsset(ctx, &state, &plan, timeout)...)
+	if response.Diagnostics.HasError() {
+		// Store the asset, so it isn't lost when the files fail to process
+		response.Diagnostics.Append(response.State.Set(ctx, state)...)
 		return
 	}
 
@@ -251,6 +394,11 @@ func (e *assetResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
+	response.Diagnostics.Append(e.uploadSources(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	// Create update parameters with version
 	params := &sdk.UpdateAssetParams{
 		XContentfulVersion: state.Version.ValueInt64(),
@@ -258,6 +406,16 @@ func (e *assetResource) Update(ctx context.Context, request resource.UpdateReque
 
 	// Update the asset
 	draft := plan.DraftForCreate() // Reuse the create draft for updates
+	if plan.IgnoreUnmanagedTags.ValueBool() {
+		if err := e.mergeUnmanagedTags(ctx, &state, draft); err != nil {
+			response.Diagnostics.AddError(
+				"Error updating asset",
+				"Could not read the current tags of the asset: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	resp, err := e.client.UpdateAssetWithResponse(
 		ctx,
 		plan.SpaceID.ValueString(),
@@ -275,9 +433,19 @@ func (e *assetResource) Update(ctx context.Context, request resource.UpdateReque
 	}
 
 	state.Import(resp.JSON200)
+	state.CopyInputValues(&plan)
+	state.Timeouts = plan.Timeouts
+
+	timeout, diags := plan.Timeouts.Update(ctx, defaultProcessingTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	if diag := e.processAsset(ctx, &state); diag != nil {
-		response.Diagnostics.Append(diag)
+	response.Diagnostics.Append(e.processAsset(ctx, &state, &plan, timeout)...)
+	if response.Diagnostics.HasError() {
+		// Store the asset, so it isn't lost when the files fail to process
+		response.Diagnostics.Append(response.State.Set(ctx, state)...)
 		return
 	}
 
@@ -302,6 +470,15 @@ func (e *assetResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
+	switch state.DeletionPolicy.ValueString() {
+	case utils.DeletionPolicyRetain:
+		tflog.Info(ctx, fmt.Sprintf("Asset %s is kept because deletion_policy is retain", state.ID.ValueString()))
+		return
+	case utils.DeletionPolicyArchive, utils.DeletionPolicyUnpublish:
+		response.Diagnostics.Append(e.unpublishOnDelete(ctx, &state)...)
+		return
+	}
+
 	// Create delete parameters with version
 	params := &sdk.DeleteAssetParams{
 		XContentfulVersion: state.Version.ValueInt64(),
@@ -330,6 +507,45 @@ func (e *assetResource) Delete(ctx context.Context, request resource.DeleteReque
 	}
 }
 
+// unpublishOnDelete unpublishes the asset instead of deleting it, and
+// archives it when the deletion policy is archive.
+func (e *assetResource) unpublishOnDelete(ctx context.Context, state *Asset) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resp, err := e.client.GetAssetWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Asset %s not found, no action needed", state.ID.ValueString()))
+			return diags
+		}
+
+		diags.AddError(
+			"Error deleting asset",
+			"Could not get latest asset version: "+err.Error(),
+		)
+		return diags
+	}
+	state.Import(resp.JSON200)
+
+	target := *state
+	target.Published = types.BoolValue(false)
+	target.PublishedLocales = types.SetNull(types.StringType)
+	target.Archived = types.BoolValue(state.Archived.ValueBool() || state.DeletionPolicy.ValueString() == utils.DeletionPolicyArchive)
+
+	if err := e.setAssetState(ctx, state, &target); err != nil {
+		diags.AddError(
+			"Error deleting asset",
+			"Could not unpublish asset: "+err.Error(),
+		)
+	}
+	return diags
+}
+
 func (e *assetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	// Extract the asset ID, space ID, and environment ID from the import ID
 	idParts, err := utils.ParseThreePartID(request.ID)
@@ -359,7 +575,15 @@ func (e *assetResource) ImportState(ctx context.Context, request resource.Import
 		return
 	}
 
-	state := &Asset{}
+	state := &Asset{
+		DeletionPolicy: types.StringValue(utils.DeletionPolicyDelete),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+			}),
+		},
+	}
 	state.Import(resp.JSON200)
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -398,33 +622,128 @@ func (e *assetResource) doRead(ctx context.Context, asset *Asset, state *tfsdk.S
  * Contentful will inspect the asset and generate a URL and set various other
  * attributes (filesize, image width, image height, etc.) based on the uploaded file.
  *
+ * Only the files of the locales that changed are processed, after which the
+ * asset is polled until all of them have a URL or the timeout runs out.
+ *
  * Note that this can also cause conflicts, if the content/type is for example
  * resolved differently by contentful, so we do some copying of the values
  * to avoid provider errors
  */
-func (e *assetResource) processAsset(ctx context.Context, state *Asset) diag.Diagnostic {
+func (e *assetResource) processAsset(ctx context.Context, state *Asset, plan *Asset, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	locales := plan.PendingLocales()
+	if len(locales) == 0 {
+		return diags
+	}
+
 	oldState := *state
 
-	// Process asset for each locale
-	for _, locale := range state.Fields.File {
+	// Process asset for each changed locale
+	for _, locale := range locales {
 		resp, err := e.client.ProcessAssetWithResponse(
 			ctx,
 			state.SpaceID.ValueString(),
 			state.Environment.ValueString(),
 			state.ID.ValueString(),
-			locale.Locale.ValueString(),
+			locale,
 		)
 		if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
-			return diag.NewErrorDiagnostic(
+			diags.AddAttributeError(
+				filePath(locale),
 				"Error processing asset",
-				fmt.Sprintf("Could not process asset for locale %s: %s", locale.Locale.ValueString(), err.Error()),
+				fmt.Sprintf("Could not process asset for locale %s: %s", locale, err.Error()),
 			)
 		}
 	}
+	if diags.HasError() {
+		return diags
+	}
+
+	asset, err := backoff.Retry(ctx, func() (*sdk.Asset, error) {
+		resp, err := e.client.GetAssetWithResponse(
+			ctx,
+			state.SpaceID.ValueString(),
+			state.Environment.ValueString(),
+			state.ID.ValueString(),
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, backoff.Permanent(err)
+		}
+
+		if len(UnprocessedLocales(resp.JSON200, locales)) > 0 {
+			return resp.JSON200, errAssetProcessing
+		}
+		return resp.JSON200, nil
+	}, backoff.WithMaxElapsedTime(timeout), backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil && asset == nil {
+		diags.AddError(
+			"Error reading asset",
+			"Could not read asset: "+err.Error(),
+		)
+		return diags
+	}
+
+	for _, locale := range UnprocessedLocales(asset, locales) {
+		diags.AddAttributeError(
+			filePath(locale),
+			"Error processing asset",
+			fmt.Sprintf("The file for locale %s was not processed within %s, check that the file is valid and can be downloaded by Contentful", locale, timeout),
+		)
+	}
+
+	state.Import(asset)
+	state.CopyInputValues(&oldState)
+
+	return diags
+}
 
-	// We should just poll the asset to see if it is done processing
-	time.Sleep(2 * time.Second)
+// uploadSources uploads the local source files and the inline content of the
+// locales that changed and links the uploads into the files.
+func (e *assetResource) uploadSources(ctx context.Context, plan *Asset) diag.Diagnostics {
+	var diags diag.Diagnostics
 
+	for locale, file := range plan.Fields.File {
+		if !file.hasLocalSource() || !file.UploadFrom.IsUnknown() {
+			continue
+		}
+
+		sourcePath := filePath(locale).AtName(file.sourceAttribute())
+		description := file.Source.ValueString()
+		if file.Source.IsNull() {
+			description = fmt.Sprintf("the %s of locale %s", file.sourceAttribute(), locale)
+		}
+
+		upload, hash, err := e.upload(ctx, plan, &file)
+		if err != nil {
+			diags.AddAttributeError(
+				sourcePath,
+				"Error uploading asset file",
+				fmt.Sprintf("Could not upload %s: %s", description, err.Error()),
+			)
+			continue
+		}
+
+		if !file.SourceHash.IsUnknown() && file.SourceHash.ValueString() != hash {
+			diags.AddAttributeError(
+				sourcePath,
+				"Asset file source changed",
+				fmt.Sprintf("%s changed after the plan was created, run terraform plan again", description),
+			)
+			continue
+		}
+
+		file.UploadFrom = types.StringValue(upload.Sys.Id)
+		file.SourceHash = types.StringValue(hash)
+		plan.Fields.File[locale] = file
+	}
+
+	return diags
+}
+
+// mergeUnmanagedTags keeps the tags that were added outside of Terraform, they
+// are not in the state so the asset is read first.
+func (e *assetResource) mergeUnmanagedTags(ctx context.Context, state *Asset, draft *sdk.AssetCreate) error {
 	resp, err := e.client.GetAssetWithResponse(
 		ctx,
 		state.SpaceID.ValueString(),
@@ -432,19 +751,67 @@ func (e *assetResource) processAsset(ctx context.Context, state *Asset) diag.Dia
 		state.ID.ValueString(),
 	)
 	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
-		return diag.NewErrorDiagnostic(
-			"Error reading asset",
-			"Could not read asset: "+err.Error(),
-		)
+		return err
+	}
+
+	utils.MergeUnmanagedTags(draft.Metadata, state.Tags, resp.JSON200.Metadata)
+	return nil
+}
+
+// upload sends the local file or the inline content of the file to the upload
+// API.
+func (e *assetResource) upload(ctx context.Context, plan *Asset, file *File) (*sdk.Upload, string, error) {
+	if !file.Source.IsNull() {
+		return utils.UploadFile(ctx, e.clientUpload, plan.SpaceID.ValueString(), plan.Environment.ValueString(), file.Source.ValueString())
 	}
 
+	content, err := file.inlineContent()
+	if err != nil {
+		return nil, "", err
+	}
+	return utils.UploadContent(ctx, e.clientUpload, plan.SpaceID.ValueString(), plan.Environment.ValueString(), content)
+}
+
+// publishLocales publishes the locales and unpublishes the other locales, the
+// asset is read first for the current status of the locales.
+func (e *assetResource) publishLocales(ctx context.Context, state *Asset, locales types.Set) error {
+	resp, err := e.client.GetAssetWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
 	state.Import(resp.JSON200)
-	state.CopyInputValues(&oldState)
+
+	body := utils.LocalePublishing(resp.JSON200.Sys.FieldStatus, locales)
+	if body == nil {
+		return nil
+	}
+
+	publishResp, err := e.client.PublishAssetWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.PublishAssetParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+		utils.WithJSONBody(body),
+	)
+	if err := utils.CheckClientResponse(publishResp, err, http.StatusOK); err != nil {
+		return err
+	}
+	if publishResp.JSON200.Sys.FieldStatus == nil {
+		return fmt.Errorf("the published locales were not returned, locale based publishing needs to be enabled for the space")
+	}
+	state.Import(publishResp.JSON200)
 
 	return nil
 }
 
-// setAssetState handles publishing and archiving based on the desired state
 func (e *assetResource) setAssetState(ctx context.Context, state *Asset, plan *Asset) error {
 	oldState := *state
 
@@ -452,7 +819,16 @@ func (e *assetResource) setAssetState(ctx context.Context, state *Asset, plan *A
 	isCurrentlyPublished := state.Published.ValueBool()
 	shouldBePublished := plan.Published.ValueBool()
 
-	if shouldBePublished && !isCurrentlyPublished {
+	// After publishing separate locales, all locales are published again when
+	// the locales are no longer set
+	wasLocaleScoped := !state.PublishedLocales.IsNull()
+	state.PublishedLocales = plan.PublishedLocales
+
+	if shouldBePublished && !plan.PublishedLocales.IsNull() {
+		if err := e.publishLocales(ctx, state, plan.PublishedLocales); err != nil {
+			return fmt.Errorf("failed to publish asset locales: %v", err)
+		}
+	} else if shouldBePublished && (!isCurrentlyPublished || wasLocaleScoped) {
 		publishParams := &sdk.PublishAssetParams{
 			XContentfulVersion: state.Version.ValueInt64(),
 		}
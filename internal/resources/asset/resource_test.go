@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	hashicor_acctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/labd/terraform-provider-contentful/internal/acctest"
@@ -36,9 +40,9 @@ func TestAssetResource_Basic(t *testing.T) {
 			{
 				Config: testAssetConfig(spaceID, environment, assetName),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "fields.title.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "fields.title.0.content", "Asset title"),
-					resource.TestCheckResourceAttr(resourceName, "fields.description.0.content", "Asset description"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Asset title"),
+					resource.TestCheckResourceAttr(resourceName, "fields.description.en-US", "Asset description"),
 					resource.TestCheckResourceAttr(resourceName, "published", "true"),
 					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
 					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
@@ -51,8 +55,8 @@ func TestAssetResource_Basic(t *testing.T) {
 			{
 				Config: testAssetUpdateConfig(spaceID, environment, assetName),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "fields.title.0.content", "Updated asset title"),
-					resource.TestCheckResourceAttr(resourceName, "fields.description.0.content", "Updated asset description"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Updated asset title"),
+					resource.TestCheckResourceAttr(resourceName, "fields.description.en-US", "Updated asset description"),
 					resource.TestCheckResourceAttr(resourceName, "published", "false"),
 					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
 						assert.Nil(t, asset.Sys.PublishedAt, "Asset should not be published")
@@ -64,7 +68,9 @@ func TestAssetResource_Basic(t *testing.T) {
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
-				ImportStateVerify: false,
+				ImportStateVerify: true,
+				// The upload URL is dropped by Contentful once the file is processed
+				ImportStateVerifyIgnore: []string{"fields.file.en-US.upload"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					rs, ok := s.RootModule().Resources[resourceName]
 					if !ok {
@@ -80,6 +86,92 @@ func TestAssetResource_Basic(t *testing.T) {
 	})
 }
 
+func TestAssetResource_Source(t *testing.T) {
+	assetName := fmt.Sprintf("asset-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_asset.myasset"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master-2026-02-20"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAssetDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetSourceConfig(spaceID, environment, assetName, "Asset title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "fields.file.en-US.source_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.upload_from"),
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.url"),
+					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
+						assert.NotNil(t, asset.Fields.File["en-US"].Url)
+					}),
+				),
+			},
+			{
+				// Changing the title keeps the processed file
+				Config: testAssetSourceConfig(spaceID, environment, assetName, "Updated asset title"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("fields").AtMapKey("file").AtMapKey("en-US").AtMapKey("url"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Updated asset title"),
+				),
+			},
+		},
+	})
+}
+
+func TestAssetResource_Content(t *testing.T) {
+	assetName := fmt.Sprintf("asset-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_asset.myasset"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master-2026-02-20"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAssetDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAssetContentConfig(spaceID, environment, assetName, `content = "{\"enabled\": true}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "fields.file.en-US.source_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.upload_from"),
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.url"),
+				),
+			},
+			{
+				// The same content as base64 doesn't upload the file again
+				Config: testAssetContentConfig(spaceID, environment, assetName, `content_base64 = base64encode("{\"enabled\": true}")`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("fields").AtMapKey("file").AtMapKey("en-US").AtMapKey("url"), knownvalue.NotNull()),
+					},
+				},
+			},
+			{
+				// Changed content is uploaded and processed again
+				Config: testAssetContentConfig(spaceID, environment, assetName, `content = "{\"enabled\": false}"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("fields").AtMapKey("file").AtMapKey("en-US").AtMapKey("url")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.url"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulAssetExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		asset, err := getAssetFromState(s, resourceName)
@@ -164,19 +256,18 @@ resource "contentful_asset" "myasset" {
   environment = "%s"
   space_id = "%s"
   fields {
-    title {
-      locale = "en-US"
-      content = "Asset title"
+    title = {
+      "en-US" = "Asset title"
     }
-    description {
-      locale = "en-US"
-      content = "Asset description"
+    description = {
+      "en-US" = "Asset description"
     }
-    file {
-      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      file_name = "example.jpeg"
-      content_type = "image/jpeg"
-      locale = "en-US"
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
     }
   }
   published = true
@@ -192,19 +283,18 @@ resource "contentful_asset" "myasset" {
   environment = "%s"
   space_id = "%s"
   fields {
-    title {
-      locale = "en-US"
-      content = "Updated asset title"
+    title = {
+      "en-US" = "Updated asset title"
     }
-    description {
-      locale = "en-US"
-      content = "Updated asset description"
+    description = {
+      "en-US" = "Updated asset description"
     }
-    file {
-      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      file_name = "example.jpeg"
-      content_type = "image/jpeg"
-      locale = "en-US"
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
     }
   }
   published = false
@@ -212,3 +302,59 @@ resource "contentful_asset" "myasset" {
 }
 `, name, environment, spaceID)
 }
+
+func testAssetSourceConfig(spaceID, environment, name, title string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "%s"
+  environment = "%s"
+  space_id = "%s"
+  fields {
+    title = {
+      "en-US" = "%s"
+    }
+    description = {
+      "en-US" = "Asset description"
+    }
+    file = {
+      "en-US" = {
+        source = "test_resources/logo.svg"
+        file_name = "logo.svg"
+        content_type = "image/svg+xml"
+      }
+    }
+  }
+  published = true
+  archived = false
+
+  timeouts {
+    create = "2m"
+    update = "2m"
+  }
+}
+`, name, environment, spaceID, title)
+}
+
+func testAssetContentConfig(spaceID, environment, name, content string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "%s"
+  environment = "%s"
+  space_id = "%s"
+  fields {
+    title = {
+      "en-US" = "Configuration"
+    }
+    file = {
+      "en-US" = {
+        %s
+        file_name = "config.json"
+        content_type = "application/json"
+      }
+    }
+  }
+  published = false
+  archived = false
+}
+`, name, environment, spaceID, content)
+}
@@ -0,0 +1,178 @@
+package asset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// assetV0 is the schema data of version 0, which stored the localized values
+// as lists of blocks with a locale attribute.
+type assetV0 struct {
+	ID          types.String   `tfsdk:"id"`
+	AssetID     types.String   `tfsdk:"asset_id"`
+	Version     types.Int64    `tfsdk:"version"`
+	SpaceID     types.String   `tfsdk:"space_id"`
+	Environment types.String   `tfsdk:"environment"`
+	Fields      *assetFieldsV0 `tfsdk:"fields"`
+	Published   types.Bool     `tfsdk:"published"`
+	Archived    types.Bool     `tfsdk:"archived"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type assetFieldsV0 struct {
+	Title       []localizedFieldV0 `tfsdk:"title"`
+	Description []localizedFieldV0 `tfsdk:"description"`
+	File        []localizedFileV0  `tfsdk:"file"`
+}
+
+type localizedFieldV0 struct {
+	Content types.String `tfsdk:"content"`
+	Locale  types.String `tfsdk:"locale"`
+}
+
+type localizedFileV0 struct {
+	Locale      types.String `tfsdk:"locale"`
+	Upload      types.String `tfsdk:"upload"`
+	UploadFrom  types.String `tfsdk:"upload_from"`
+	Source      types.String `tfsdk:"source"`
+	SourceHash  types.String `tfsdk:"source_hash"`
+	URL         types.String `tfsdk:"url"`
+	FileName    types.String `tfsdk:"file_name"`
+	ContentType types.String `tfsdk:"content_type"`
+	FileSize    types.Int64  `tfsdk:"filesize"`
+	ImageWidth  types.Int64  `tfsdk:"image_width"`
+	ImageHeight types.Int64  `tfsdk:"image_height"`
+}
+
+func schemaV0(ctx context.Context) *schema.Schema {
+	localizedField := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"content": schema.StringAttribute{Required: true},
+				"locale":  schema.StringAttribute{Required: true},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"asset_id":    schema.StringAttribute{Required: true},
+			"version":     schema.Int64Attribute{Computed: true},
+			"space_id":    schema.StringAttribute{Required: true},
+			"environment": schema.StringAttribute{Required: true},
+			"published":   schema.BoolAttribute{Required: true},
+			"archived":    schema.BoolAttribute{Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"fields": schema.SingleNestedBlock{
+				Blocks: map[string]schema.Block{
+					"title":       localizedField,
+					"description": localizedField,
+					"file": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"locale":       schema.StringAttribute{Required: true},
+								"upload":       schema.StringAttribute{Optional: true},
+								"upload_from":  schema.StringAttribute{Optional: true, Computed: true},
+								"source":       schema.StringAttribute{Optional: true},
+								"source_hash":  schema.StringAttribute{Computed: true},
+								"url":          schema.StringAttribute{Computed: true},
+								"file_name":    schema.StringAttribute{Required: true},
+								"content_type": schema.StringAttribute{Optional: true, Computed: true},
+								"filesize":     schema.Int64Attribute{Computed: true},
+								"image_width":  schema.Int64Attribute{Computed: true},
+								"image_height": schema.Int64Attribute{Computed: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *assetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: schemaV0(ctx),
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var prior assetV0
+				response.Diagnostics.Append(request.State.Get(ctx, &prior)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				response.Diagnostics.Append(response.State.Set(ctx, upgradeV0(&prior))...)
+			},
+		},
+	}
+}
+
+// upgradeV0 moves the localized values of version 0 into maps keyed by the
+// locale code.
+func upgradeV0(prior *assetV0) *Asset {
+	asset := &Asset{
+		ID:               prior.ID,
+		AssetID:          prior.AssetID,
+		Version:          prior.Version,
+		SpaceID:          prior.SpaceID,
+		Environment:      prior.Environment,
+		Tags:             types.SetNull(types.StringType),
+		Concepts:         types.SetNull(types.StringType),
+		Published:        prior.Published,
+		PublishedLocales: types.SetNull(types.StringType),
+		Archived:         prior.Archived,
+		DeletionPolicy:   types.StringValue(utils.DeletionPolicyDelete),
+		Timeouts:         prior.Timeouts,
+	}
+
+	if prior.Fields == nil {
+		return asset
+	}
+
+	asset.Fields = &AssetFields{}
+	for _, item := range prior.Fields.Title {
+		if asset.Fields.Title == nil {
+			asset.Fields.Title = map[string]types.String{}
+		}
+		asset.Fields.Title[item.Locale.ValueString()] = item.Content
+	}
+
+	for _, item := range prior.Fields.Description {
+		if asset.Fields.Description == nil {
+			asset.Fields.Description = map[string]types.String{}
+		}
+		asset.Fields.Description[item.Locale.ValueString()] = item.Content
+	}
+
+	for _, item := range prior.Fields.File {
+		if asset.Fields.File == nil {
+			asset.Fields.File = map[string]File{}
+		}
+		asset.Fields.File[item.Locale.ValueString()] = File{
+			Upload:      item.Upload,
+			UploadFrom:  item.UploadFrom,
+			Source:      item.Source,
+			SourceHash:  item.SourceHash,
+			URL:         item.URL,
+			FileName:    item.FileName,
+			ContentType: item.ContentType,
+			FileSize:    item.FileSize,
+			ImageWidth:  item.ImageWidth,
+			ImageHeight: item.ImageHeight,
+		}
+	}
+
+	return asset
+}
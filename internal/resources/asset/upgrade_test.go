@@ -0,0 +1,93 @@
+package asset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stateV0 is a state written by a provider version before files could be
+// uploaded from a local source.
+const stateV0 = `{
+  "id": "logo",
+  "asset_id": "logo",
+  "version": 4,
+  "space_id": "space",
+  "environment": "master",
+  "published": true,
+  "archived": false,
+  "fields": {
+    "title": [
+      {"locale": "en-US", "content": "Logo"},
+      {"locale": "de-DE", "content": "Logo DE"}
+    ],
+    "description": [],
+    "file": [
+      {
+        "locale": "en-US",
+        "upload": "https://example.com/logo.svg",
+        "url": "//images.ctfassets.net/logo.svg",
+        "file_name": "logo.svg",
+        "content_type": "image/svg+xml",
+        "filesize": 120,
+        "image_width": 64,
+        "image_height": 64
+      }
+    ]
+  }
+}`
+
+func TestAssetUpgradeState_V0(t *testing.T) {
+	ctx := context.Background()
+	r := &assetResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	raw, err := (&tfprotov6.RawState{JSON: []byte(stateV0)}).UnmarshalWithOpts(
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	require.NoError(t, err)
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	request := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: raw},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var asset Asset
+	require.False(t, response.State.Get(ctx, &asset).HasError())
+
+	assert.Equal(t, "logo", asset.ID.ValueString())
+	assert.Equal(t, int64(4), asset.Version.ValueInt64())
+	assert.True(t, asset.Timeouts.IsNull())
+	assert.Equal(t, "delete", asset.DeletionPolicy.ValueString())
+	assert.Equal(t, map[string]types.String{
+		"en-US": types.StringValue("Logo"),
+		"de-DE": types.StringValue("Logo DE"),
+	}, asset.Fields.Title)
+	assert.Nil(t, asset.Fields.Description)
+
+	require.Contains(t, asset.Fields.File, "en-US")
+	file := asset.Fields.File["en-US"]
+	assert.Equal(t, "https://example.com/logo.svg", file.Upload.ValueString())
+	assert.Equal(t, "//images.ctfassets.net/logo.svg", file.URL.ValueString())
+	assert.Equal(t, int64(64), file.ImageWidth.ValueInt64())
+	assert.True(t, file.Source.IsNull())
+	assert.True(t, file.UploadFrom.IsNull())
+}
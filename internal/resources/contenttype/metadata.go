@@ -0,0 +1,267 @@
+package contenttype
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Taxonomy is a concept or a concept scheme that entries of the content type
+// can be tagged with.
+type Taxonomy struct {
+	ConceptID       types.String `tfsdk:"concept_id"`
+	ConceptSchemeID types.String `tfsdk:"concept_scheme_id"`
+	Required        types.Bool   `tfsdk:"required"`
+}
+
+func (t Taxonomy) Draft() sdk.TaxonomyValidation {
+	link := sdk.SystemPropertiesLink{
+		Type:     "Link",
+		LinkType: "TaxonomyConcept",
+		Id:       t.ConceptID.ValueString(),
+	}
+	if !t.ConceptSchemeID.IsNull() {
+		link.LinkType = "TaxonomyConceptScheme"
+		link.Id = t.ConceptSchemeID.ValueString()
+	}
+
+	return sdk.TaxonomyValidation{
+		Sys:      link,
+		Required: utils.Pointer(t.Required.ValueBool()),
+	}
+}
+
+func (t *Taxonomy) Import(n sdk.TaxonomyValidation) {
+	t.ConceptID = types.StringNull()
+	t.ConceptSchemeID = types.StringNull()
+	if n.Sys.LinkType == "TaxonomyConceptScheme" {
+		t.ConceptSchemeID = types.StringValue(n.Sys.Id)
+	} else {
+		t.ConceptID = types.StringValue(n.Sys.Id)
+	}
+	t.Required = types.BoolValue(n.Required != nil && *n.Required)
+}
+
+func (t Taxonomy) Equal(o Taxonomy) bool {
+	return t.ConceptID.Equal(o.ConceptID) &&
+		t.ConceptSchemeID.Equal(o.ConceptSchemeID) &&
+		t.Required.ValueBool() == o.Required.ValueBool()
+}
+
+// Metadata creates the annotations and taxonomy validations for a request.
+// Values that are not set are taken from current, the metadata in Contentful,
+// so annotations that are added in the web app or by Compose are kept.
+func (c *ContentType) Metadata(current *sdk.ContentTypeMetadata) *sdk.ContentTypeMetadata {
+	if current == nil {
+		current = &sdk.ContentTypeMetadata{}
+	}
+	currentAnnotations := current.Annotations
+	if currentAnnotations == nil {
+		currentAnnotations = &sdk.ContentTypeAnnotations{}
+	}
+	currentFields := map[string][]sdk.AnnotationLink{}
+	if currentAnnotations.ContentTypeField != nil {
+		currentFields = *currentAnnotations.ContentTypeField
+	}
+
+	annotations := &sdk.ContentTypeAnnotations{
+		ContentType: annotationLinks(c.Annotations, currentAnnotations.ContentType),
+	}
+
+	fields := map[string][]sdk.AnnotationLink{}
+	for _, field := range c.Fields {
+		previous, ok := currentFields[field.Id.ValueString()]
+		if !ok && !field.RenamedFrom.IsNull() {
+			previous = currentFields[field.RenamedFrom.ValueString()]
+		}
+
+		previousLinks := &previous
+		if previous == nil {
+			previousLinks = nil
+		}
+
+		if links := annotationLinks(field.Annotations, previousLinks); links != nil && len(*links) > 0 {
+			fields[field.Id.ValueString()] = *links
+		}
+	}
+	if len(fields) > 0 {
+		annotations.ContentTypeField = &fields
+	}
+
+	metadata := &sdk.ContentTypeMetadata{
+		Taxonomy: current.Taxonomy,
+	}
+	if annotations.ContentType != nil || annotations.ContentTypeField != nil {
+		metadata.Annotations = annotations
+	}
+	if c.Taxonomy != nil {
+		taxonomy := []sdk.TaxonomyValidation{}
+		for _, t := range c.Taxonomy {
+			taxonomy = append(taxonomy, t.Draft())
+		}
+		metadata.Taxonomy = &taxonomy
+	}
+
+	if metadata.Annotations == nil && metadata.Taxonomy == nil {
+		return nil
+	}
+	return metadata
+}
+
+// importMetadata sets the annotations and taxonomy validations from
+// Contentful. Values that are not set in the configuration stay null, so
+// changes in the web app don't show up as a difference.
+func (c *ContentType) importMetadata(n *sdk.ContentTypeMetadata, managed map[string]bool) {
+	annotations := &sdk.ContentTypeAnnotations{}
+	if n != nil && n.Annotations != nil {
+		annotations = n.Annotations
+	}
+
+	c.Annotations = annotationIDs(c.Annotations, annotations.ContentType)
+
+	for i, field := range c.Fields {
+		var links *[]sdk.AnnotationLink
+		if annotations.ContentTypeField != nil {
+			if value, ok := (*annotations.ContentTypeField)[field.Id.ValueString()]; ok {
+				links = &value
+			}
+		}
+
+		current := types.SetNull(types.StringType)
+		if managed[field.Id.ValueString()] {
+			current = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+		c.Fields[i].Annotations = annotationIDs(current, links)
+	}
+
+	if c.Taxonomy == nil {
+		return
+	}
+
+	c.Taxonomy = []Taxonomy{}
+	if n != nil && n.Taxonomy != nil {
+		for _, value := range *n.Taxonomy {
+			taxonomy := Taxonomy{}
+			taxonomy.Import(value)
+			c.Taxonomy = append(c.Taxonomy, taxonomy)
+		}
+	}
+}
+
+// metadataEqual returns whether the annotations and taxonomy validations that
+// are set in the configuration are the same in Contentful.
+func (c *ContentType) metadataEqual(n *sdk.ContentTypeMetadata) bool {
+	imported := &ContentType{
+		Annotations: c.Annotations,
+		Taxonomy:    c.Taxonomy,
+		Fields:      make([]Field, len(c.Fields)),
+	}
+	managed := map[string]bool{}
+	for i, field := range c.Fields {
+		imported.Fields[i] = Field{Id: field.Id}
+		managed[field.Id.ValueString()] = !field.Annotations.IsNull()
+	}
+	imported.importMetadata(n, managed)
+
+	if !imported.Annotations.Equal(typedSet(c.Annotations)) {
+		return false
+	}
+	for i, field := range c.Fields {
+		if !imported.Fields[i].Annotations.Equal(typedSet(field.Annotations)) {
+			return false
+		}
+	}
+
+	if len(imported.Taxonomy) != len(c.Taxonomy) {
+		return false
+	}
+	for i, taxonomy := range c.Taxonomy {
+		if !taxonomy.Equal(imported.Taxonomy[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// annotationLinks returns the links to the annotations in ids. The parameters
+// of annotations that Contentful already has are kept, and when ids is not set
+// the current links are returned.
+func annotationLinks(ids types.Set, current *[]sdk.AnnotationLink) *[]sdk.AnnotationLink {
+	if ids.IsNull() || ids.IsUnknown() {
+		return current
+	}
+
+	parameters := map[string]*map[string]any{}
+	if current != nil {
+		for _, link := range *current {
+			parameters[link.Sys.Id] = link.Parameters
+		}
+	}
+
+	links := []sdk.AnnotationLink{}
+	for _, id := range setStrings(ids) {
+		links = append(links, sdk.AnnotationLink{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: "Annotation",
+				Id:       id,
+			},
+			Parameters: parameters[id],
+		})
+	}
+	return &links
+}
+
+// annotationIDs returns the IDs of the annotations in links, or null when the
+// annotations were not set before.
+func annotationIDs(current types.Set, links *[]sdk.AnnotationLink) types.Set {
+	if current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	values := []attr.Value{}
+	if links != nil {
+		for _, link := range *links {
+			values = append(values, types.StringValue(link.Sys.Id))
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// typedSet returns the set with its element type, as the zero value of a set
+// has none.
+func typedSet(set types.Set) types.Set {
+	if set.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return set
+}
+
+func setStrings(set types.Set) []string {
+	var result []string
+	for _, value := range set.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// renameFieldAnnotations moves the annotations of a field that is renamed to
+// the ID the field still has in the request.
+func renameFieldAnnotations(metadata *sdk.ContentTypeMetadata, id, previousID string) {
+	if metadata == nil || metadata.Annotations == nil || metadata.Annotations.ContentTypeField == nil {
+		return
+	}
+
+	fields := *metadata.Annotations.ContentTypeField
+	if links, ok := fields[id]; ok {
+		delete(fields, id)
+		fields[previousID] = links
+	}
+}
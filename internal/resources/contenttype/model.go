@@ -21,6 +21,10 @@ type ContentType struct {
 	Description  types.String `tfsdk:"description"`
 	Version      types.Int64  `tfsdk:"version"`
 	Fields       []Field      `tfsdk:"fields"`
+	Annotations  types.Set    `tfsdk:"annotations"`
+	Taxonomy     []Taxonomy   `tfsdk:"taxonomy"`
+
+	AllowFieldDataLoss types.Bool `tfsdk:"allow_field_data_loss"`
 }
 
 type Field struct {
@@ -32,6 +36,8 @@ type Field struct {
 	Localized    types.Bool    `tfsdk:"localized"`
 	Disabled     types.Bool    `tfsdk:"disabled"`
 	Omitted      types.Bool    `tfsdk:"omitted"`
+	RenamedFrom  types.String  `tfsdk:"renamed_from"`
+	Annotations  types.Set     `tfsdk:"annotations"`
 	Validations  []Validation  `tfsdk:"validations"`
 	Items        *Items        `tfsdk:"items"`
 	DefaultValue *DefaultValue `tfsdk:"default_value"`
@@ -100,18 +106,21 @@ func (d *DefaultValue) Draft() *map[string]any {
 }
 
 type Validation struct {
-	Unique            types.Bool     `tfsdk:"unique"`
-	Size              *Size          `tfsdk:"size"`
-	Range             *Size          `tfsdk:"range"`
-	AssetFileSize     *Size          `tfsdk:"asset_file_size"`
-	Regexp            *Regexp        `tfsdk:"regexp"`
-	LinkContentType   []types.String `tfsdk:"link_content_type"`
-	LinkMimetypeGroup []types.String `tfsdk:"link_mimetype_group"`
-	In                []types.String `tfsdk:"in"`
-	EnabledMarks      []types.String `tfsdk:"enabled_marks"`
-	EnabledNodeTypes  []types.String `tfsdk:"enabled_node_types"`
-	Message           types.String   `tfsdk:"message"`
-	Nodes             *Nodes         `tfsdk:"nodes"`
+	Unique               types.Bool       `tfsdk:"unique"`
+	Size                 *Size            `tfsdk:"size"`
+	Range                *Size            `tfsdk:"range"`
+	DateRange            *DateRange       `tfsdk:"date_range"`
+	AssetFileSize        *Size            `tfsdk:"asset_file_size"`
+	AssetImageDimensions *ImageDimensions `tfsdk:"asset_image_dimensions"`
+	Regexp               *Regexp          `tfsdk:"regexp"`
+	ProhibitRegexp       *Regexp          `tfsdk:"prohibit_regexp"`
+	LinkContentType      []types.String   `tfsdk:"link_content_type"`
+	LinkMimetypeGroup    []types.String   `tfsdk:"link_mimetype_group"`
+	In                   []types.String   `tfsdk:"in"`
+	EnabledMarks         []types.String   `tfsdk:"enabled_marks"`
+	EnabledNodeTypes     []types.String   `tfsdk:"enabled_node_types"`
+	Message              types.String     `tfsdk:"message"`
+	Nodes                *Nodes           `tfsdk:"nodes"`
 }
 
 func (v Validation) Draft() (*sdk.FieldValidation, error) {
@@ -142,6 +151,14 @@ func (v Validation) Draft() (*sdk.FieldValidation, error) {
 		counter++
 	}
 
+	if v.DateRange != nil {
+		base.DateRange = &sdk.RangeDate{
+			Min: v.DateRange.Min.ValueStringPointer(),
+			Max: v.DateRange.Max.ValueStringPointer(),
+		}
+		counter++
+	}
+
 	if v.AssetFileSize != nil {
 		base.AssetFileSize = &sdk.RangeMinMax{
 			Min: v.AssetFileSize.Min.ValueFloat64Pointer(),
@@ -150,10 +167,18 @@ func (v Validation) Draft() (*sdk.FieldValidation, error) {
 		counter++
 	}
 
+	if v.AssetImageDimensions != nil {
+		base.AssetImageDimensions = v.AssetImageDimensions.Draft()
+		counter++
+	}
+
 	if v.Regexp != nil {
-		base.Regexp = &sdk.RegexValidationValue{
-			Pattern: v.Regexp.Pattern.ValueString(),
-		}
+		base.Regexp = v.Regexp.Draft()
+		counter++
+	}
+
+	if v.ProhibitRegexp != nil {
+		base.ProhibitRegexp = v.ProhibitRegexp.Draft()
 		counter++
 	}
 
@@ -219,8 +244,46 @@ type Size struct {
 	Max types.Float64 `tfsdk:"max"`
 }
 
+type DateRange struct {
+	Min types.String `tfsdk:"min"`
+	Max types.String `tfsdk:"max"`
+}
+
+type ImageDimensions struct {
+	Width  *Size `tfsdk:"width"`
+	Height *Size `tfsdk:"height"`
+}
+
+func (d *ImageDimensions) Draft() *sdk.RangeImageDimensions {
+	base := &sdk.RangeImageDimensions{}
+
+	if d.Width != nil {
+		base.Width = &sdk.RangeMinMaxInteger{
+			Min: d.Width.Min.ValueFloat64Pointer(),
+			Max: d.Width.Max.ValueFloat64Pointer(),
+		}
+	}
+
+	if d.Height != nil {
+		base.Height = &sdk.RangeMinMaxInteger{
+			Min: d.Height.Min.ValueFloat64Pointer(),
+			Max: d.Height.Max.ValueFloat64Pointer(),
+		}
+	}
+
+	return base
+}
+
 type Regexp struct {
 	Pattern types.String `tfsdk:"pattern"`
+	Flags   types.String `tfsdk:"flags"`
+}
+
+func (r *Regexp) Draft() *sdk.RegexValidationValue {
+	return &sdk.RegexValidationValue{
+		Pattern: r.Pattern.ValueString(),
+		Flags:   r.Flags.ValueStringPointer(),
+	}
 }
 
 type Nodes struct {
@@ -669,6 +732,7 @@ func (f *Field) Import(n sdk.Field) error {
 	f.Omitted = types.BoolPointerValue(n.Omitted)
 	f.Localized = types.BoolValue(n.Localized)
 	f.Disabled = types.BoolPointerValue(n.Disabled)
+	f.Annotations = types.SetNull(types.StringType)
 
 	if n.LinkType == nil {
 		f.LinkType = types.StringNull()
@@ -887,6 +951,7 @@ func (c *ContentType) Create() (*sdk.ContentTypeCreate, error) {
 		Name:         c.Name.ValueString(),
 		DisplayField: c.DisplayField.ValueStringPointer(),
 		Fields:       fields,
+		Metadata:     c.Metadata(nil),
 	}
 
 	if !c.Description.IsNull() && !c.Description.IsUnknown() {
@@ -913,6 +978,7 @@ func (c *ContentType) Update() (*sdk.ContentTypeUpdate, error) {
 		Name:         c.Name.ValueString(),
 		DisplayField: c.DisplayField.ValueStringPointer(),
 		Fields:       fields,
+		Metadata:     c.Metadata(nil),
 	}
 
 	if !c.Description.IsNull() && !c.Description.IsUnknown() {
@@ -922,6 +988,34 @@ func (c *ContentType) Update() (*sdk.ContentTypeUpdate, error) {
 	return contentfulType, nil
 }
 
+// Renames returns the new IDs of the fields that are renamed, by the ID the
+// field has in Contentful. A field is renamed when Contentful has the field
+// it was renamed from, but not the field itself yet.
+func (c *ContentType) Renames(n *sdk.ContentType) map[string]string {
+	renames := map[string]string{}
+	hasField := func(fields []sdk.Field, id string) bool {
+		return pie.FindFirstUsing(fields, func(f sdk.Field) bool {
+			return f.Id == id
+		}) != -1
+	}
+	planned := func(id string) bool {
+		return pie.FindFirstUsing(c.Fields, func(f Field) bool {
+			return f.Id.ValueString() == id
+		}) != -1
+	}
+
+	for _, field := range c.Fields {
+		previousID := field.RenamedFrom.ValueString()
+		if previousID == "" || previousID == field.Id.ValueString() || planned(previousID) {
+			continue
+		}
+		if hasField(n.Fields, previousID) && !hasField(n.Fields, field.Id.ValueString()) {
+			renames[previousID] = field.Id.ValueString()
+		}
+	}
+	return renames
+}
+
 func (c *ContentType) Import(n *sdk.ContentType) error {
 	c.ID = types.StringValue(n.Sys.Id)
 	c.Version = types.Int64Value(n.Sys.Version)
@@ -931,6 +1025,14 @@ func (c *ContentType) Import(n *sdk.ContentType) error {
 	c.Name = types.StringValue(n.Name)
 	c.DisplayField = types.StringPointerValue(n.DisplayField)
 
+	// The previous IDs of renamed fields are only known to the configuration
+	renamedFrom := map[string]types.String{}
+	managedAnnotations := map[string]bool{}
+	for _, field := range c.Fields {
+		renamedFrom[field.Id.ValueString()] = field.RenamedFrom
+		managedAnnotations[field.Id.ValueString()] = !field.Annotations.IsNull()
+	}
+
 	var fields []Field
 
 	for _, nf := range n.Fields {
@@ -939,10 +1041,14 @@ func (c *ContentType) Import(n *sdk.ContentType) error {
 		if err != nil {
 			return fmt.Errorf("field import failed: %w", err)
 		}
+		if value, ok := renamedFrom[nf.Id]; ok {
+			field.RenamedFrom = value
+		}
 		fields = append(fields, *field)
 	}
 
 	c.Fields = fields
+	c.importMetadata(n.Metadata, managedAnnotations)
 
 	return nil
 
@@ -966,6 +1072,10 @@ func (c *ContentType) Equal(n *sdk.ContentType) bool {
 		return false
 	}
 
+	if !c.metadataEqual(n.Metadata) {
+		return false
+	}
+
 	for idxOrg, field := range c.Fields {
 		idx := pie.FindFirstUsing(n.Fields, func(f sdk.Field) bool {
 			return f.Id == field.Id.ValueString()
@@ -1031,10 +1141,52 @@ func getValidation(cfVal sdk.FieldValidation) (*Validation, error) {
 		}, nil
 	}
 
+	if cfVal.DateRange != nil {
+		return &Validation{
+			DateRange: &DateRange{
+				Max: types.StringPointerValue(cfVal.DateRange.Max),
+				Min: types.StringPointerValue(cfVal.DateRange.Min),
+			},
+			Message: types.StringPointerValue(cfVal.Message),
+		}, nil
+	}
+
+	if cfVal.AssetImageDimensions != nil {
+		dimensions := &ImageDimensions{}
+		if cfVal.AssetImageDimensions.Width != nil {
+			dimensions.Width = &Size{
+				Max: types.Float64PointerValue(cfVal.AssetImageDimensions.Width.Max),
+				Min: types.Float64PointerValue(cfVal.AssetImageDimensions.Width.Min),
+			}
+		}
+		if cfVal.AssetImageDimensions.Height != nil {
+			dimensions.Height = &Size{
+				Max: types.Float64PointerValue(cfVal.AssetImageDimensions.Height.Max),
+				Min: types.Float64PointerValue(cfVal.AssetImageDimensions.Height.Min),
+			}
+		}
+
+		return &Validation{
+			AssetImageDimensions: dimensions,
+			Message:              types.StringPointerValue(cfVal.Message),
+		}, nil
+	}
+
 	if cfVal.Regexp != nil {
 		return &Validation{
 			Regexp: &Regexp{
 				Pattern: types.StringValue(cfVal.Regexp.Pattern),
+				Flags:   types.StringPointerValue(cfVal.Regexp.Flags),
+			},
+			Message: types.StringPointerValue(cfVal.Message),
+		}, nil
+	}
+
+	if cfVal.ProhibitRegexp != nil {
+		return &Validation{
+			ProhibitRegexp: &Regexp{
+				Pattern: types.StringValue(cfVal.ProhibitRegexp.Pattern),
+				Flags:   types.StringPointerValue(cfVal.ProhibitRegexp.Flags),
 			},
 			Message: types.StringPointerValue(cfVal.Message),
 		}, nil
@@ -1,13 +1,16 @@
 package contenttype
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidationDraftReturnsErrorForUnsupportedValidation(t *testing.T) {
@@ -47,6 +50,282 @@ func TestValidationDraftReturnsCorrectEnabledNodeTypesValidation(t *testing.T) {
 	assert.Equal(t, "Unique validation message", *result.Message)
 }
 
+func TestValidation_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation string
+	}{
+		{"DateRange", `{"dateRange": {"min": "2026-01-01", "max": "2026-12-31T23:59:59"}, "message": "Only this year"}`},
+		{"DateRangeMin", `{"dateRange": {"min": "2026-01-01"}}`},
+		{"ProhibitRegexp", `{"prohibitRegexp": {"pattern": "^draft", "flags": "i"}}`},
+		{"RegexpFlags", `{"regexp": {"pattern": "^[a-z]+$", "flags": "m"}}`},
+		{"AssetImageDimensions", `{"assetImageDimensions": {"width": {"min": 100, "max": 1920}, "height": {"max": 1080}}}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfVal sdk.FieldValidation
+			require.NoError(t, json.Unmarshal([]byte(tc.validation), &cfVal))
+
+			validation, err := getValidation(cfVal)
+			require.NoError(t, err)
+			assert.True(t, compareValidations([]Validation{*validation}, &[]sdk.FieldValidation{cfVal}))
+
+			draft, err := validation.Draft()
+			require.NoError(t, err)
+			data, err := json.Marshal(draft)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.validation, string(data))
+		})
+	}
+}
+
+func TestItems_ProhibitRegexp(t *testing.T) {
+	items := &Items{
+		Type: types.StringValue("Symbol"),
+		Validations: []Validation{{
+			ProhibitRegexp: &Regexp{Pattern: types.StringValue("^draft"), Flags: types.StringNull()},
+		}},
+	}
+
+	native, err := items.ToNative()
+	require.NoError(t, err)
+	assert.True(t, items.Equal(native))
+
+	data, err := json.Marshal(native)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "Symbol", "validations": [{"prohibitRegexp": {"pattern": "^draft"}}]}`, string(data))
+
+	items.Validations[0].ProhibitRegexp.Flags = types.StringValue("i")
+	assert.False(t, items.Equal(native))
+}
+
+func TestContentType_Renames(t *testing.T) {
+	contentType := &ContentType{Fields: []Field{
+		{Id: types.StringValue("headline"), RenamedFrom: types.StringValue("title")},
+		{Id: types.StringValue("body"), RenamedFrom: types.StringNull()},
+		{Id: types.StringValue("summary"), RenamedFrom: types.StringValue("missing")},
+	}}
+
+	remote := &sdk.ContentType{Fields: []sdk.Field{{Id: "title"}, {Id: "body"}}}
+	assert.Equal(t, map[string]string{"title": "headline"}, contentType.Renames(remote))
+
+	// Once the field is renamed there is nothing left to do
+	remote = &sdk.ContentType{Fields: []sdk.Field{{Id: "headline"}, {Id: "body"}}}
+	assert.Empty(t, contentType.Renames(remote))
+
+	// The previous ID of the field is kept in the state
+	require.NoError(t, contentType.Import(&sdk.ContentType{Fields: []sdk.Field{{Id: "headline", Type: "Symbol"}, {Id: "body", Type: "Text"}}}))
+	assert.Equal(t, "title", contentType.Fields[0].RenamedFrom.ValueString())
+	assert.True(t, contentType.Fields[1].RenamedFrom.IsNull())
+}
+
+func TestContentType_MetadataRoundTrip(t *testing.T) {
+	annotation := func(id string) sdk.AnnotationLink {
+		return sdk.AnnotationLink{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Annotation", Id: id}}
+	}
+	remote := &sdk.ContentType{
+		Fields: []sdk.Field{{Id: "title", Type: "Symbol"}, {Id: "sections", Type: "Text"}},
+		Metadata: &sdk.ContentTypeMetadata{
+			Annotations: &sdk.ContentTypeAnnotations{
+				ContentType: &[]sdk.AnnotationLink{annotation("Contentful:AggregateRoot")},
+				ContentTypeField: &map[string][]sdk.AnnotationLink{
+					"sections": {annotation("Contentful:AggregateComponent")},
+				},
+			},
+			Taxonomy: &[]sdk.TaxonomyValidation{
+				{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "TaxonomyConcept", Id: "color"}, Required: ptr(true)},
+				{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "TaxonomyConceptScheme", Id: "topics"}},
+			},
+		},
+	}
+
+	contentType := &ContentType{
+		Annotations: types.SetValueMust(types.StringType, []attr.Value{}),
+		Taxonomy:    []Taxonomy{},
+		Fields: []Field{
+			{Id: types.StringValue("title"), Annotations: types.SetNull(types.StringType)},
+			{Id: types.StringValue("sections"), Annotations: types.SetValueMust(types.StringType, []attr.Value{})},
+		},
+	}
+	require.NoError(t, contentType.Import(remote))
+
+	assert.Equal(t, []string{"Contentful:AggregateRoot"}, setStrings(contentType.Annotations))
+	assert.True(t, contentType.Fields[0].Annotations.IsNull())
+	assert.Equal(t, []string{"Contentful:AggregateComponent"}, setStrings(contentType.Fields[1].Annotations))
+	require.Len(t, contentType.Taxonomy, 2)
+	assert.Equal(t, "color", contentType.Taxonomy[0].ConceptID.ValueString())
+	assert.True(t, contentType.Taxonomy[0].Required.ValueBool())
+	assert.Equal(t, "topics", contentType.Taxonomy[1].ConceptSchemeID.ValueString())
+	assert.False(t, contentType.Taxonomy[1].Required.ValueBool())
+	assert.True(t, contentType.metadataEqual(remote.Metadata))
+
+	draft, err := contentType.Update()
+	require.NoError(t, err)
+	assert.Equal(t, remote.Metadata.Annotations, draft.Metadata.Annotations)
+	assert.Equal(t, "TaxonomyConceptScheme", (*draft.Metadata.Taxonomy)[1].Sys.LinkType)
+	assert.False(t, *(*draft.Metadata.Taxonomy)[1].Required)
+
+	// Annotations that are not set keep the value in Contentful
+	contentType.Annotations = types.SetNull(types.StringType)
+	contentType.Fields[1].Annotations = types.SetNull(types.StringType)
+	contentType.Taxonomy = nil
+	assert.Equal(t, remote.Metadata, contentType.Metadata(remote.Metadata))
+	assert.True(t, contentType.metadataEqual(remote.Metadata))
+
+	// Changed annotations keep the parameters Contentful has for them
+	parameters := map[string]any{"max": float64(3)}
+	(*remote.Metadata.Annotations.ContentType)[0].Parameters = &parameters
+	contentType.Annotations = types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("Contentful:AggregateRoot"),
+		types.StringValue("Contentful:ExperienceType"),
+	})
+	assert.False(t, contentType.metadataEqual(remote.Metadata))
+
+	metadata := contentType.Metadata(remote.Metadata)
+	links := *metadata.Annotations.ContentType
+	require.Len(t, links, 2)
+	assert.Equal(t, &parameters, links[0].Parameters)
+	assert.Nil(t, links[1].Parameters)
+}
+
+func TestContentType_Validate(t *testing.T) {
+	contentType := &ContentType{
+		DisplayField: types.StringValue("tags"),
+		Fields: []Field{
+			{
+				Id:   types.StringValue("title"),
+				Type: types.StringValue("Symbol"),
+				Validations: []Validation{
+					{Unique: types.BoolValue(true)},
+					{Regexp: &Regexp{Pattern: types.StringValue("^[a-z]+$")}},
+				},
+			},
+			{
+				Id:          types.StringValue("featured"),
+				Type:        types.StringValue("Boolean"),
+				Validations: []Validation{{Regexp: &Regexp{Pattern: types.StringValue("^true$")}}},
+				Items:       &Items{Type: types.StringValue("Symbol")},
+			},
+			{
+				Id:       types.StringValue("image"),
+				Type:     types.StringValue("Link"),
+				LinkType: types.StringValue("Asset"),
+				Validations: []Validation{
+					{LinkMimetypeGroup: []types.String{types.StringValue("image")}},
+					{LinkContentType: []types.String{types.StringValue("author")}},
+				},
+			},
+			{
+				Id:   types.StringValue("tags"),
+				Type: types.StringValue("Array"),
+				Items: &Items{
+					Type:        types.StringValue("Symbol"),
+					Validations: []Validation{{In: []types.String{types.StringValue("news")}}, {Range: &Size{}}},
+				},
+			},
+		},
+	}
+
+	diags := contentType.Validate()
+	paths := []string{}
+	for _, d := range diags.Errors() {
+		paths = append(paths, d.(diag.DiagnosticWithPath).Path().String())
+	}
+	assert.Equal(t, []string{
+		"fields[1].items",
+		"fields[1].validations[0].regexp",
+		"fields[2].validations[1].link_content_type",
+		"fields[3].items.validations[1].range",
+	}, paths)
+
+	// A display field that is not Symbol or Text is accepted by Contentful
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "display_field", diags.Warnings()[0].(diag.DiagnosticWithPath).Path().String())
+
+	contentType.DisplayField = types.StringValue("missing")
+	assert.True(t, contentType.validateDisplayField().HasError())
+
+	// Checks are skipped for values that are not known yet
+	contentType.DisplayField = types.StringValue("title")
+	contentType.Fields = contentType.Fields[:1]
+	contentType.Fields[0].Type = types.StringUnknown()
+	assert.False(t, contentType.Validate().HasError())
+}
+
+func TestContentType_LinkedContentTypes(t *testing.T) {
+	contentType := &ContentType{Fields: []Field{
+		{
+			Id: types.StringValue("author"),
+			Validations: []Validation{{
+				LinkContentType: []types.String{types.StringValue("person"), types.StringUnknown()},
+			}},
+		},
+		{
+			Id: types.StringValue("body"),
+			Validations: []Validation{{
+				Nodes: &Nodes{EmbeddedEntryBlock: []EmbeddedEntryBlockValidation{{
+					LinkContentType: &[]types.String{types.StringValue("quote")},
+				}}},
+			}},
+		},
+		{
+			Id: types.StringValue("related"),
+			Items: &Items{Validations: []Validation{{
+				LinkContentType: []types.String{types.StringValue("article")},
+			}}},
+		},
+	}}
+
+	linked := contentType.linkedContentTypes()
+	require.Len(t, linked, 3)
+	assert.Equal(t, "person", linked[0].ID)
+	assert.Equal(t, "fields[0].validations[0].link_content_type[0]", linked[0].Path.String())
+	assert.Equal(t, "quote", linked[1].ID)
+	assert.Equal(t, "fields[1].validations[0].nodes.embedded_entry_block[0].link_content_type[0]", linked[1].Path.String())
+	assert.Equal(t, "article", linked[2].ID)
+	assert.Equal(t, "fields[2].items.validations[0].link_content_type[0]", linked[2].Path.String())
+}
+
+func TestRemovedFields(t *testing.T) {
+	fieldType := map[string]attr.Type{
+		"id":           types.StringType,
+		"renamed_from": types.StringType,
+		"omitted":      types.BoolType,
+	}
+	field := func(id string, renamedFrom types.String, omitted bool) attr.Value {
+		return types.ObjectValueMust(fieldType, map[string]attr.Value{
+			"id":           types.StringValue(id),
+			"renamed_from": renamedFrom,
+			"omitted":      types.BoolValue(omitted),
+		})
+	}
+	list := func(fields ...attr.Value) types.List {
+		return types.ListValueMust(types.ObjectType{AttrTypes: fieldType}, fields)
+	}
+
+	state, ok := fieldRefs(list(
+		field("title", types.StringNull(), false),
+		field("body", types.StringNull(), false),
+		field("legacy", types.StringNull(), true),
+		field("summary", types.StringNull(), false),
+	))
+	require.True(t, ok)
+
+	plan, ok := fieldRefs(list(
+		field("headline", types.StringValue("title"), false),
+		field("body", types.StringNull(), false),
+	))
+	require.True(t, ok)
+
+	// Renamed fields are kept and omitted fields can be removed
+	assert.Equal(t, []string{"summary"}, removedFields(state, plan))
+
+	// Fields that are not known yet can not be checked
+	_, ok = fieldRefs(list(field("title", types.StringUnknown(), false)))
+	assert.False(t, ok)
+}
+
 func TestDefaultValue_HasContent(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,154 @@
+package contenttype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// fieldRef holds the attributes of a field that tell whether it is removed.
+// They are read from the list of fields directly, so a plan with unknown
+// values in other attributes of the fields can still be checked.
+type fieldRef struct {
+	ID          types.String
+	RenamedFrom types.String
+	Omitted     types.Bool
+}
+
+// fieldRefs returns the fields of the list, false when the fields are not
+// known yet.
+func fieldRefs(fields types.List) ([]fieldRef, bool) {
+	if fields.IsNull() || fields.IsUnknown() {
+		return nil, false
+	}
+
+	refs := []fieldRef{}
+	for _, element := range fields.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return nil, false
+		}
+
+		ref := fieldRef{
+			ID:          types.StringNull(),
+			RenamedFrom: types.StringNull(),
+			Omitted:     types.BoolNull(),
+		}
+		attributes := object.Attributes()
+		if value, ok := attributes["id"].(types.String); ok {
+			ref.ID = value
+		}
+		if value, ok := attributes["renamed_from"].(types.String); ok {
+			ref.RenamedFrom = value
+		}
+		if value, ok := attributes["omitted"].(types.Bool); ok {
+			ref.Omitted = value
+		}
+
+		if ref.ID.IsUnknown() || ref.RenamedFrom.IsUnknown() {
+			return nil, false
+		}
+		refs = append(refs, ref)
+	}
+	return refs, true
+}
+
+// removedFields returns the IDs of the fields in the state that are removed
+// by the plan. Fields that are renamed are kept, and fields that were omitted
+// first have no values that are visible to the editors.
+func removedFields(state, plan []fieldRef) []string {
+	kept := map[string]bool{}
+	for _, field := range plan {
+		kept[field.ID.ValueString()] = true
+		if !field.RenamedFrom.IsNull() {
+			kept[field.RenamedFrom.ValueString()] = true
+		}
+	}
+
+	removed := []string{}
+	for _, field := range state {
+		if kept[field.ID.ValueString()] || field.Omitted.ValueBool() {
+			continue
+		}
+		removed = append(removed, field.ID.ValueString())
+	}
+	return removed
+}
+
+// checkFieldRemoval fails the plan when a field that is removed has values in
+// entries, because removing the field deletes these values.
+func (e *contentTypeResource) checkFieldRemoval(ctx context.Context, request resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var allowDataLoss types.Bool
+	var stateFields, planFields types.List
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root("allow_field_data_loss"), &allowDataLoss)...)
+	diags.Append(request.State.GetAttribute(ctx, path.Root("fields"), &stateFields)...)
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root("fields"), &planFields)...)
+	if diags.HasError() || allowDataLoss.ValueBool() || allowDataLoss.IsUnknown() {
+		return diags
+	}
+
+	state, ok := fieldRefs(stateFields)
+	if !ok {
+		return diags
+	}
+	plan, ok := fieldRefs(planFields)
+	if !ok {
+		return diags
+	}
+
+	removed := removedFields(state, plan)
+	if len(removed) == 0 {
+		return diags
+	}
+
+	var spaceID, environment, contentTypeID types.String
+	diags.Append(request.State.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	diags.Append(request.State.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	diags.Append(request.State.GetAttribute(ctx, path.Root("id"), &contentTypeID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, fieldID := range removed {
+		resp, err := e.client.GetAllEntriesWithResponse(
+			ctx,
+			spaceID.ValueString(),
+			environment.ValueString(),
+			&sdk.GetAllEntriesParams{
+				ContentType: contentTypeID.ValueStringPointer(),
+				Limit:       utils.Pointer(1),
+			},
+			utils.AddQueryParameter("fields."+fieldID+"[exists]", "true"),
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			diags.AddError(
+				"Error checking removed field",
+				fmt.Sprintf("Could not count the entries with a value for field %s: %s", fieldID, err.Error()),
+			)
+			return diags
+		}
+
+		if resp.JSON200.Total == nil || *resp.JSON200.Total == 0 {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("fields"),
+			"Removing field deletes entry data",
+			fmt.Sprintf("Field %s of content type %s has a value in %d entries, which are deleted when the field is "+
+				"removed. Set omitted to true on the field and apply that first, or set allow_field_data_loss to true "+
+				"to remove the field with its values.", fieldID, contentTypeID.ValueString(), *resp.JSON200.Total),
+		)
+	}
+	return diags
+}
@@ -38,9 +38,11 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &contentTypeResource{}
-	_ resource.ResourceWithConfigure   = &contentTypeResource{}
-	_ resource.ResourceWithImportState = &contentTypeResource{}
+	_ resource.Resource                   = &contentTypeResource{}
+	_ resource.ResourceWithConfigure      = &contentTypeResource{}
+	_ resource.ResourceWithImportState    = &contentTypeResource{}
+	_ resource.ResourceWithModifyPlan     = &contentTypeResource{}
+	_ resource.ResourceWithValidateConfig = &contentTypeResource{}
 )
 
 func NewContentTypeResource() resource.Resource {
@@ -50,6 +52,7 @@ func NewContentTypeResource() resource.Resource {
 // contentTypeResource is the resource implementation.
 type contentTypeResource struct {
 	client *sdk.ClientWithResponses
+	cache  *utils.Cache
 }
 
 func (e *contentTypeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -103,6 +106,19 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 		},
 	}
 
+	regexpSchema := schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"pattern": schema.StringAttribute{
+				Optional: true,
+			},
+			"flags": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The flags of the regular expression, like `i` to ignore the case.",
+			},
+		},
+	}
+
 	validationsSchema := schema.ListNestedAttribute{
 		Optional: true,
 		NestedObject: schema.NestedAttributeObject{
@@ -112,15 +128,29 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 				"unique": schema.BoolAttribute{
 					Optional: true,
 				},
-				"asset_file_size": sizeSchema,
-				"regexp": schema.SingleNestedAttribute{
-					Optional: true,
+				"date_range": schema.SingleNestedAttribute{
+					Optional:            true,
+					MarkdownDescription: "The earliest and latest date of a Date field, as an ISO 8601 date with an optional time.",
 					Attributes: map[string]schema.Attribute{
-						"pattern": schema.StringAttribute{
+						"min": schema.StringAttribute{
+							Optional: true,
+						},
+						"max": schema.StringAttribute{
 							Optional: true,
 						},
 					},
 				},
+				"asset_file_size": sizeSchema,
+				"asset_image_dimensions": schema.SingleNestedAttribute{
+					Optional:            true,
+					MarkdownDescription: "The width and height in pixels of images that are linked in an asset field.",
+					Attributes: map[string]schema.Attribute{
+						"width":  sizeSchema,
+						"height": sizeSchema,
+					},
+				},
+				"regexp":          regexpSchema,
+				"prohibit_regexp": regexpSchema,
 				"link_mimetype_group": schema.ListAttribute{
 					Optional:    true,
 					ElementType: types.StringType,
@@ -281,6 +311,43 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
+			"annotations": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "IDs of the annotations of the content type, like `Contentful:AggregateRoot`. When not set, " +
+					"the annotations that are managed in the web app are kept.",
+			},
+			"taxonomy": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Taxonomy concepts and concept schemes that entries of the content type can be tagged with. " +
+					"When not set, the taxonomy that is managed in the web app is kept.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"concept_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("concept_scheme_id")),
+							},
+						},
+						"concept_scheme_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"required": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Whether entries have to be tagged with the concept or with a concept of the scheme.",
+							PlanModifiers: []planmodifier.Bool{
+								custommodifier.BoolDefault(false),
+							},
+						},
+					},
+				},
+			},
+			"allow_field_data_loss": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Allows removing fields that still have a value in entries, which deletes these values. " +
+					"Without it a field has to be marked as `omitted` and applied before it can be removed.",
+			},
 			"fields": schema.ListNestedAttribute{
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
@@ -337,6 +404,17 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 								custommodifier.BoolDefault(false),
 							},
 						},
+						"renamed_from": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The previous ID of the field. When the content type has a field with this ID, it is " +
+								"renamed to `id` and the entries keep their values, instead of removing the field and adding a new one.",
+						},
+						"annotations": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							MarkdownDescription: "IDs of the annotations of the field, like `Contentful:AggregateComponent`. When " +
+								"not set, the annotations that are managed in the web app are kept.",
+						},
 						"validations": validationsSchema,
 						"items": schema.SingleNestedAttribute{
 							Optional: true,
@@ -403,7 +481,38 @@ func (e *contentTypeResource) Configure(_ context.Context, request resource.Conf
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.cache = data.Cache
+}
+
+func (e *contentTypeResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	// The configuration can only be read when the fields are known, they are
+	// validated again once they are
+	var config ContentType
+	if diags := request.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
 
+	response.Diagnostics.Append(config.Validate()...)
+}
+
+func (e *contentTypeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	e.planContentType(ctx, request)
+
+	var plan ContentType
+	if diags := request.Plan.Get(ctx, &plan); !diags.HasError() {
+		response.Diagnostics.Append(e.checkLinkedContentTypes(ctx, &plan)...)
+	}
+
+	// Nothing is removed when the content type is created
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	response.Diagnostics.Append(e.checkFieldRemoval(ctx, request)...)
 }
 
 func (e *contentTypeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -557,8 +666,15 @@ func (e *contentTypeResource) Update(ctx context.Context, request resource.Updat
 	}
 	plan.Version = types.Int64Value(contentfulContentType.Sys.Version)
 
+	// Fields that are renamed keep their values, Contentful moves them to the
+	// new ID of the field
+	renames := plan.Renames(contentfulContentType)
+
 	// Mark the fields as omitted that are no longer in the plan
 	deletedFields := pie.Of(pie.FilterNot(contentfulContentType.Fields, func(cf sdk.Field) bool {
+		if _, ok := renames[cf.Id]; ok {
+			return true
+		}
 		return pie.FindFirstUsing(plan.Fields, func(f Field) bool {
 			return cf.Id == f.Id.ValueString()
 		}) != -1
@@ -575,6 +691,17 @@ func (e *contentTypeResource) Update(ctx context.Context, request resource.Updat
 		response.Diagnostics.AddError("Error updating contenttype", err.Error())
 		return
 	}
+	draft.Metadata = plan.Metadata(contentfulContentType.Metadata)
+
+	for i, field := range draft.Fields {
+		for previousID, id := range renames {
+			if field.Id == id {
+				draft.Fields[i].Id = previousID
+				draft.Fields[i].NewId = utils.Pointer(id)
+				renameFieldAnnotations(draft.Metadata, id, previousID)
+			}
+		}
+	}
 
 	if len(deletedFields) > 0 {
 		draft.Fields = append(draft.Fields, deletedFields...)
@@ -604,6 +731,7 @@ func (e *contentTypeResource) Update(ctx context.Context, request resource.Updat
 				response.Diagnostics.AddError("Error updating contenttype", err.Error())
 				return
 			}
+			draft.Metadata = plan.Metadata(contentfulContentType.Metadata)
 
 			contentType, err = e.doUpdate(ctx, plan, draft)
 			if err != nil {
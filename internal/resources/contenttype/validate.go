@@ -0,0 +1,306 @@
+package contenttype
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// displayFieldTypes are the types of the fields that the web app shows as the
+// title of an entry. Contentful accepts other fields as the display field too.
+var displayFieldTypes = []string{"Symbol", "Text"}
+
+// validationFieldTypes are the field types that each kind of validation can be
+// used on. Links are listed with their link type.
+var validationFieldTypes = map[string][]string{
+	"unique":                 {"Symbol", "Integer", "Number"},
+	"size":                   {"Symbol", "Text", "RichText", "Object", "Array"},
+	"range":                  {"Integer", "Number"},
+	"date_range":             {"Date"},
+	"regexp":                 {"Symbol", "Text"},
+	"prohibit_regexp":        {"Symbol", "Text"},
+	"in":                     {"Symbol", "Text", "Integer", "Number"},
+	"link_content_type":      {"Link:Entry"},
+	"link_mimetype_group":    {"Link:Asset"},
+	"asset_file_size":        {"Link:Asset"},
+	"asset_image_dimensions": {"Link:Asset"},
+	"enabled_marks":          {"RichText"},
+	"enabled_node_types":     {"RichText"},
+	"nodes":                  {"RichText"},
+}
+
+// linkedContentType is a content type that a validation refers to, with the
+// path that errors about it are reported on.
+type linkedContentType struct {
+	ID   string
+	Path path.Path
+}
+
+// kinds returns the names of the validations that are set
+func (v Validation) kinds() []string {
+	set := map[string]bool{
+		"unique":                 !v.Unique.IsNull(),
+		"size":                   v.Size != nil,
+		"range":                  v.Range != nil,
+		"date_range":             v.DateRange != nil,
+		"regexp":                 v.Regexp != nil,
+		"prohibit_regexp":        v.ProhibitRegexp != nil,
+		"in":                     v.In != nil,
+		"link_content_type":      v.LinkContentType != nil,
+		"link_mimetype_group":    v.LinkMimetypeGroup != nil,
+		"asset_file_size":        v.AssetFileSize != nil,
+		"asset_image_dimensions": v.AssetImageDimensions != nil,
+		"enabled_marks":          v.EnabledMarks != nil,
+		"enabled_node_types":     v.EnabledNodeTypes != nil,
+		"nodes":                  v.Nodes != nil,
+	}
+
+	var kinds []string
+	for kind, ok := range set {
+		if ok {
+			kinds = append(kinds, kind)
+		}
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// validationAllowed returns whether the validation can be used on a field of
+// the type. When the link type of a link is not known yet, any link
+// validation is allowed.
+func validationAllowed(kind string, fieldType, linkType types.String) bool {
+	allowed := validationFieldTypes[kind]
+	if fieldType.ValueString() != "Link" {
+		return slices.Contains(allowed, fieldType.ValueString())
+	}
+
+	if linkType.IsNull() || linkType.IsUnknown() {
+		return slices.Contains(allowed, "Link:Entry") || slices.Contains(allowed, "Link:Asset")
+	}
+	return slices.Contains(allowed, "Link:"+linkType.ValueString())
+}
+
+// typeName describes the type of a field for errors, like "Link (Asset)"
+func typeName(fieldType, linkType types.String) string {
+	if fieldType.ValueString() == "Link" && !linkType.IsNull() && !linkType.IsUnknown() {
+		return fmt.Sprintf("Link (%s)", linkType.ValueString())
+	}
+	return fieldType.ValueString()
+}
+
+// Validate checks that the display field exists and that the validations fit
+// the types of the fields. Values that are not known yet are skipped.
+func (c *ContentType) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(c.validateDisplayField()...)
+
+	for i, field := range c.Fields {
+		if field.Type.IsUnknown() {
+			continue
+		}
+		fieldPath := path.Root("fields").AtListIndex(i)
+
+		if field.Items != nil && field.Type.ValueString() != "Array" {
+			diags.AddAttributeError(
+				fieldPath.AtName("items"),
+				"Invalid field items",
+				fmt.Sprintf("Field %s is a %s field, items can only be set on Array fields",
+					field.Id.ValueString(), field.Type.ValueString()),
+			)
+		}
+
+		diags.Append(validateKinds(field.Validations, field.Id, field.Type, field.LinkType, fieldPath)...)
+
+		if field.Items != nil && !field.Items.Type.IsUnknown() {
+			diags.Append(validateKinds(field.Items.Validations, field.Id, field.Items.Type, field.Items.LinkType,
+				fieldPath.AtName("items"))...)
+		}
+	}
+
+	return diags
+}
+
+func (c *ContentType) validateDisplayField() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c.DisplayField.IsNull() || c.DisplayField.IsUnknown() {
+		return diags
+	}
+
+	var ids []string
+	for _, field := range c.Fields {
+		if field.Id.IsUnknown() {
+			return diags
+		}
+		ids = append(ids, field.Id.ValueString())
+
+		if field.Id.ValueString() != c.DisplayField.ValueString() {
+			continue
+		}
+		if !field.Type.IsUnknown() && !slices.Contains(displayFieldTypes, field.Type.ValueString()) {
+			diags.AddAttributeWarning(
+				path.Root("display_field"),
+				"Display field is not shown as the title",
+				fmt.Sprintf("Field %s is a %s field, the web app only shows Symbol and Text fields as the title of "+
+					"an entry", field.Id.ValueString(), field.Type.ValueString()),
+			)
+		}
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("display_field"),
+		"Invalid display field",
+		fmt.Sprintf("The content type has no field %s, the available fields are: %s",
+			c.DisplayField.ValueString(), strings.Join(ids, ", ")),
+	)
+	return diags
+}
+
+func validateKinds(validations []Validation, fieldID, fieldType, linkType types.String, base path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for j, validation := range validations {
+		for _, kind := range validation.kinds() {
+			if validationAllowed(kind, fieldType, linkType) {
+				continue
+			}
+			diags.AddAttributeError(
+				base.AtName("validations").AtListIndex(j).AtName(kind),
+				"Invalid field validation",
+				fmt.Sprintf("The %s validation can't be used on field %s, which is a %s field",
+					kind, fieldID.ValueString(), typeName(fieldType, linkType)),
+			)
+		}
+	}
+	return diags
+}
+
+// linkedContentTypes returns the known content types that the validations of
+// the fields refer to.
+func (c *ContentType) linkedContentTypes() []linkedContentType {
+	var result []linkedContentType
+	for i, field := range c.Fields {
+		fieldPath := path.Root("fields").AtListIndex(i)
+		result = append(result, validationLinks(field.Validations, fieldPath)...)
+		if field.Items != nil {
+			result = append(result, validationLinks(field.Items.Validations, fieldPath.AtName("items"))...)
+		}
+	}
+	return result
+}
+
+func validationLinks(validations []Validation, base path.Path) []linkedContentType {
+	var result []linkedContentType
+	add := func(ids []types.String, at path.Path) {
+		for k, id := range ids {
+			if id.IsNull() || id.IsUnknown() {
+				continue
+			}
+			result = append(result, linkedContentType{ID: id.ValueString(), Path: at.AtListIndex(k)})
+		}
+	}
+	addNode := func(ids *[]types.String, at path.Path) {
+		if ids != nil {
+			add(*ids, at.AtName("link_content_type"))
+		}
+	}
+
+	for j, validation := range validations {
+		validationPath := base.AtName("validations").AtListIndex(j)
+		add(validation.LinkContentType, validationPath.AtName("link_content_type"))
+
+		if validation.Nodes == nil {
+			continue
+		}
+		nodesPath := validationPath.AtName("nodes")
+		for k, node := range validation.Nodes.EntryHyperlink {
+			addNode(node.LinkContentType, nodesPath.AtName("entry_hyperlink").AtListIndex(k))
+		}
+		for k, node := range validation.Nodes.EmbeddedEntryBlock {
+			addNode(node.LinkContentType, nodesPath.AtName("embedded_entry_block").AtListIndex(k))
+		}
+		for k, node := range validation.Nodes.EmbeddedEntryInline {
+			addNode(node.LinkContentType, nodesPath.AtName("embedded_entry_inline").AtListIndex(k))
+		}
+	}
+	return result
+}
+
+// planContentType records the content type with the IDs of its planned fields,
+// so the resources that are planned after it can use the fields that are
+// added in the same apply.
+func (e *contentTypeResource) planContentType(ctx context.Context, request resource.ModifyPlanRequest) {
+	var spaceID, environment, id types.String
+	var fields types.List
+	if diags := request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID); diags.HasError() {
+		return
+	}
+	if diags := request.Plan.GetAttribute(ctx, path.Root("environment"), &environment); diags.HasError() {
+		return
+	}
+	if diags := request.Plan.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		return
+	}
+	if spaceID.IsUnknown() || environment.IsUnknown() || id.IsUnknown() || id.IsNull() {
+		return
+	}
+
+	var fieldIDs []string
+	if diags := request.Plan.GetAttribute(ctx, path.Root("fields"), &fields); !diags.HasError() {
+		if refs, ok := fieldRefs(fields); ok {
+			fieldIDs = []string{}
+			for _, ref := range refs {
+				fieldIDs = append(fieldIDs, ref.ID.ValueString())
+			}
+		}
+	}
+
+	utils.PlanContentType(e.cache, spaceID.ValueString(), environment.ValueString(), id.ValueString(), fieldIDs)
+}
+
+// checkLinkedContentTypes checks that the content types that the validations
+// refer to exist. Content types that are planned before this one, like the
+// ones it refers to with their id attribute, are created in the same apply.
+func (e *contentTypeResource) checkLinkedContentTypes(ctx context.Context, plan *ContentType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.SpaceId.IsUnknown() || plan.Environment.IsUnknown() {
+		return diags
+	}
+
+	spaceID := plan.SpaceId.ValueString()
+	environment := plan.Environment.ValueString()
+
+	for _, linked := range plan.linkedContentTypes() {
+		if linked.ID == plan.ID.ValueString() || e.cache.Has(utils.PlannedContentTypeKey(spaceID, environment, linked.ID)) {
+			continue
+		}
+
+		contentType, err := utils.CachedContentType(ctx, e.client, e.cache, spaceID, environment, linked.ID)
+		if err != nil {
+			diags.AddError(
+				"Error validating contenttype",
+				"Could not read content type "+linked.ID+": "+err.Error(),
+			)
+			return diags
+		}
+
+		if contentType == nil {
+			diags.AddAttributeError(
+				linked.Path,
+				"Unknown content type",
+				fmt.Sprintf("Content type %s does not exist in environment %s and is not managed in this configuration. "+
+					"Refer to a content type that is created in the same apply with its id attribute, so it is planned first.",
+					linked.ID, environment),
+			)
+		}
+	}
+	return diags
+}
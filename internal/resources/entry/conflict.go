@@ -0,0 +1,197 @@
+package entry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// The strategies for an entry that was changed in Contentful between the plan
+// and the apply, which Contentful reports as a version conflict.
+const (
+	ConflictStrategyFail      = "fail"
+	ConflictStrategyOverwrite = "overwrite"
+	ConflictStrategyMerge     = "merge"
+)
+
+// localeValues converts the fields of a draft or of an entry to plain values
+// by field ID and locale, so they can be compared.
+func localeValues(fields *orderedmap.OrderedMap) map[string]map[string]any {
+	result := map[string]map[string]any{}
+	if fields == nil {
+		return result
+	}
+
+	values, _ := normalize(fields).(map[string]any)
+	for fieldID, locales := range values {
+		result[fieldID] = map[string]any{}
+		if locales, ok := locales.(map[string]any); ok {
+			for locale, value := range locales {
+				result[fieldID][locale] = value
+			}
+		}
+	}
+	return result
+}
+
+// ChangedFields returns the fields and locales that have a different value,
+// formatted as field_id.locale.
+func ChangedFields(before, after *orderedmap.OrderedMap) []string {
+	a := localeValues(before)
+	b := localeValues(after)
+
+	changed := []string{}
+	for _, key := range fieldLocales(a, b) {
+		fieldID, locale := key[0], key[1]
+		if !reflect.DeepEqual(a[fieldID][locale], b[fieldID][locale]) {
+			changed = append(changed, fieldID+"."+locale)
+		}
+	}
+	return changed
+}
+
+// MergeFields applies the values that Terraform changes, the difference
+// between the state and the plan, to the fields in Contentful. Values that
+// Terraform does not change keep the value that editors gave them.
+func MergeFields(remote, state, plan *orderedmap.OrderedMap) *orderedmap.OrderedMap {
+	result := localeValues(remote)
+	before := localeValues(state)
+	after := localeValues(plan)
+
+	for _, key := range fieldLocales(before, after) {
+		fieldID, locale := key[0], key[1]
+		value, ok := after[fieldID][locale]
+		if reflect.DeepEqual(before[fieldID][locale], value) {
+			continue
+		}
+
+		if !ok {
+			delete(result[fieldID], locale)
+			continue
+		}
+		if result[fieldID] == nil {
+			result[fieldID] = map[string]any{}
+		}
+		result[fieldID][locale] = value
+	}
+
+	fieldIDs := make([]string, 0, len(result))
+	for fieldID, locales := range result {
+		if len(locales) > 0 {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+	}
+	sort.Strings(fieldIDs)
+
+	fields := orderedmap.New()
+	for _, fieldID := range fieldIDs {
+		fields.Set(fieldID, result[fieldID])
+	}
+	return fields
+}
+
+// fieldLocales returns the sorted field IDs and locales of both values
+func fieldLocales(a, b map[string]map[string]any) [][2]string {
+	seen := map[[2]string]bool{}
+	for _, values := range []map[string]map[string]any{a, b} {
+		for fieldID, locales := range values {
+			for locale := range locales {
+				seen[[2]string{fieldID, locale}] = true
+			}
+		}
+	}
+
+	result := make([][2]string, 0, len(seen))
+	for key := range seen {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i][0] != result[j][0] {
+			return result[i][0] < result[j][0]
+		}
+		return result[i][1] < result[j][1]
+	})
+	return result
+}
+
+// resolveConflict handles an update that failed because the entry was changed
+// in Contentful after it was read. prior is the draft of the state before the
+// update, which is what Terraform knew about the entry.
+func (e *entryResource) resolveConflict(ctx context.Context, state *Entry, plan *Entry, prior sdk.EntryDraft, draft sdk.EntryDraft) (*sdk.Entry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resp, err := e.client.GetEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		diags.AddError(
+			"Error updating entry",
+			"Could not read the entry after a version conflict: "+err.Error(),
+		)
+		return nil, diags
+	}
+	remote := resp.JSON200
+
+	switch {
+	case plan.IsSeeded():
+		// The fields of a seeded entry belong to the editors anyway
+		draft.Fields = &remote.Fields
+	case plan.ConflictStrategy.ValueString() == ConflictStrategyOverwrite:
+	case plan.ConflictStrategy.ValueString() == ConflictStrategyMerge:
+		merged := MergeFields(&remote.Fields, prior.Fields, draft.Fields)
+		if fields := ChangedFields(draft.Fields, merged); len(fields) > 0 {
+			diags.AddWarning(
+				"Entry fields were merged",
+				fmt.Sprintf("Entry %s was changed in Contentful and the changes to %s were kept, the next plan shows them "+
+					"as a difference with the configuration", state.ID.ValueString(), strings.Join(fields, ", ")),
+			)
+		}
+		draft.Fields = merged
+	default:
+		changed := "only the metadata or the status changed"
+		if fields := ChangedFields(prior.Fields, &remote.Fields); len(fields) > 0 {
+			changed = "the changed fields are " + strings.Join(fields, ", ")
+		}
+		diags.AddError(
+			"Entry was changed in Contentful",
+			fmt.Sprintf("Entry %s was changed to version %d after it was read at version %d, %s. Run a new plan to see "+
+				"the changes, or set conflict_strategy to overwrite or merge", state.ID.ValueString(), remote.Sys.Version,
+				state.Version.ValueInt64(), changed),
+		)
+		return nil, diags
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Entry %s was changed in Contentful, updating version %d", state.ID.ValueString(), remote.Sys.Version))
+
+	updated, err := e.client.UpdateEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.UpdateEntryParams{
+			XContentfulVersion: remote.Sys.Version,
+		},
+		draft,
+	)
+	if err := utils.CheckClientResponse(updated, err, http.StatusOK); err != nil {
+		diags.AddError(
+			"Error updating entry",
+			"Could not update entry after a version conflict: "+err.Error(),
+		)
+		return nil, diags
+	}
+	return updated.JSON200, diags
+}
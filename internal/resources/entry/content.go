@@ -0,0 +1,111 @@
+package entry
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*FieldContentType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*FieldContent)(nil)
+)
+
+// FieldContentType is the type of the content of a field block. The content
+// is either plain text or JSON, which is compared by value instead of by its
+// formatting, like jsontypes.Normalized does for attributes that are always
+// JSON.
+type FieldContentType struct {
+	basetypes.StringType
+}
+
+func (t FieldContentType) String() string {
+	return "entry.FieldContentType"
+}
+
+func (t FieldContentType) ValueType(_ context.Context) attr.Value {
+	return FieldContent{}
+}
+
+func (t FieldContentType) Equal(o attr.Type) bool {
+	other, ok := o.(FieldContentType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t FieldContentType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return FieldContent{StringValue: in}, nil
+}
+
+func (t FieldContentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// FieldContent is the content of a field block
+type FieldContent struct {
+	basetypes.StringValue
+}
+
+func NewFieldContentValue(value string) FieldContent {
+	return FieldContent{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v FieldContent) Type(_ context.Context) attr.Type {
+	return FieldContentType{}
+}
+
+func (v FieldContent) Equal(o attr.Value) bool {
+	other, ok := o.(FieldContent)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both contents send the same value to
+// Contentful, so whitespace, the order of keys and the formatting of numbers
+// in JSON content don't cause a diff.
+func (v FieldContent) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(FieldContent)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return ContentEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ContentEqual reports whether both contents are parsed to the same value
+func ContentEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return reflect.DeepEqual(normalize(ParseContentValue(a)), normalize(ParseContentValue(b)))
+}
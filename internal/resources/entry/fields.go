@@ -0,0 +1,264 @@
+package entry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// The fields attribute is a dynamic value of field IDs to locales to the
+// value of the field. Terraform infers the type from the configuration, so a
+// number stays a number and a rich text document an object.
+
+// FieldValues converts the fields attribute to plain values by field ID and
+// locale, unknown and null values are left out.
+func FieldValues(fields types.Dynamic) map[string]map[string]any {
+	result := map[string]map[string]any{}
+	if fields.IsNull() || fields.IsUnknown() {
+		return result
+	}
+
+	for fieldID, locales := range elements(fields.UnderlyingValue()) {
+		values := map[string]any{}
+		for locale, value := range elements(locales) {
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+			values[locale] = plainValue(value)
+		}
+		result[fieldID] = values
+	}
+	return result
+}
+
+// validateFields checks that the fields attribute is a map of field IDs to a
+// map of locales.
+func validateFields(fields types.Dynamic) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if fields.IsNull() || fields.IsUnknown() || fields.IsUnderlyingValueUnknown() {
+		return diags
+	}
+
+	if !isMap(fields.UnderlyingValue()) {
+		diags.AddAttributeError(
+			path.Root("fields"),
+			"Invalid entry fields",
+			"fields needs to be a map of field IDs to a map of locale codes to the value",
+		)
+		return diags
+	}
+
+	for fieldID, locales := range elements(fields.UnderlyingValue()) {
+		if locales.IsUnknown() || isMap(locales) {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("fields"),
+			"Invalid entry fields",
+			fmt.Sprintf("The value of field %s needs to be a map of locale codes to the value, for example { \"en-US\" = ... }", fieldID),
+		)
+	}
+	return diags
+}
+
+// fieldsFromAPI builds the fields attribute from the fields of an entry.
+func fieldsFromAPI(fields orderedmap.OrderedMap) types.Dynamic {
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for _, fieldID := range fields.Keys() {
+		value, _ := fields.Get(fieldID)
+		object := dynamicValue(value)
+		attrTypes[fieldID] = object.Type(context.Background())
+		attrs[fieldID] = object
+	}
+	return types.DynamicValue(types.ObjectValueMust(attrTypes, attrs))
+}
+
+// fieldsEqual reports whether both field values hold the same content,
+// regardless of the Terraform types, like a list or a tuple.
+func fieldsEqual(a, b types.Dynamic) bool {
+	return reflect.DeepEqual(normalize(FieldValues(a)), normalize(FieldValues(b)))
+}
+
+// sameDraft reports whether both drafts send the same content to Contentful.
+func sameDraft(a, b sdk.EntryDraft) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// normalize converts a value to its JSON representation, so values that are
+// decoded from the API and values from the configuration can be compared.
+func normalize(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var result any
+	if err := json.Unmarshal(data, &result); err != nil {
+		return value
+	}
+	return result
+}
+
+func isMap(value attr.Value) bool {
+	switch value.(type) {
+	case types.Object, types.Map:
+		return true
+	}
+	return false
+}
+
+func elements(value attr.Value) map[string]attr.Value {
+	switch v := value.(type) {
+	case types.Object:
+		return v.Attributes()
+	case types.Map:
+		return v.Elements()
+	case types.Dynamic:
+		return elements(v.UnderlyingValue())
+	}
+	return nil
+}
+
+// plainValue converts a Terraform value to the value that is sent to
+// Contentful.
+func plainValue(value attr.Value) any {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return plainValue(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString()
+	case types.Bool:
+		return v.ValueBool()
+	case types.Number:
+		// Whole numbers outside of the int64 range are sent as a float
+		if v.ValueBigFloat().IsInt() {
+			if i, accuracy := v.ValueBigFloat().Int64(); accuracy == big.Exact {
+				return i
+			}
+		}
+		f, _ := v.ValueBigFloat().Float64()
+		return f
+	case types.Int64:
+		return v.ValueInt64()
+	case types.Float64:
+		return v.ValueFloat64()
+	case types.Object, types.Map:
+		result := orderedmap.New()
+		values := elements(v)
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result.Set(key, plainValue(values[key]))
+		}
+		return result
+	case types.List:
+		return plainValues(v.Elements())
+	case types.Set:
+		return plainValues(v.Elements())
+	case types.Tuple:
+		return plainValues(v.Elements())
+	}
+	return nil
+}
+
+func plainValues(values []attr.Value) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, plainValue(value))
+	}
+	return result
+}
+
+// dynamicValue converts a value decoded from the API to a Terraform value,
+// using the types Terraform infers for the same value in the configuration.
+func dynamicValue(value any) attr.Value {
+	switch v := value.(type) {
+	case string:
+		return types.StringValue(v)
+	case bool:
+		return types.BoolValue(v)
+	case float64:
+		return types.NumberValue(big.NewFloat(v))
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return types.StringValue(v.String())
+		}
+		return types.NumberValue(f)
+	case orderedmap.OrderedMap:
+		return objectValue(v.Keys(), func(key string) any {
+			item, _ := v.Get(key)
+			return item
+		})
+	case *orderedmap.OrderedMap:
+		return dynamicValue(*v)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		return objectValue(keys, func(key string) any { return v[key] })
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem := dynamicValue(item)
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		return types.TupleValueMust(elemTypes, elems)
+	}
+	return types.DynamicNull()
+}
+
+func objectValue(keys []string, get func(string) any) attr.Value {
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for _, key := range keys {
+		item := get(key)
+		if item == nil {
+			continue
+		}
+		value := dynamicValue(item)
+		attrTypes[key] = value.Type(context.Background())
+		attrs[key] = value
+	}
+	return types.ObjectValueMust(attrTypes, attrs)
+}
+
+// draftFields creates the fields of the entry for the API from the fields
+// attribute.
+func draftFields(fields types.Dynamic) *orderedmap.OrderedMap {
+	values := FieldValues(fields)
+
+	fieldIDs := make([]string, 0, len(values))
+	for fieldID := range values {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Strings(fieldIDs)
+
+	result := orderedmap.New()
+	for _, fieldID := range fieldIDs {
+		result.Set(fieldID, values[fieldID])
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package entry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iancoleman/orderedmap"
+)
+
+// FieldLink is a single link of the links attribute of a field block
+type FieldLink struct {
+	EntryID types.String `tfsdk:"entry_id"`
+	AssetID types.String `tfsdk:"asset_id"`
+}
+
+var fieldLinkType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"entry_id": types.StringType,
+		"asset_id": types.StringType,
+	},
+}
+
+// Value returns the value of the field block that is sent to Contentful, the
+// link attributes are converted to Link objects.
+func (f Field) Value() any {
+	switch {
+	case !f.EntryLink.IsNull():
+		return linkValue("Entry", f.EntryLink.ValueString())
+	case !f.AssetLink.IsNull():
+		return linkValue("Asset", f.AssetLink.ValueString())
+	case !f.Links.IsNull():
+		result := []any{}
+		for _, link := range f.links() {
+			if !link.AssetID.IsNull() {
+				result = append(result, linkValue("Asset", link.AssetID.ValueString()))
+			} else {
+				result = append(result, linkValue("Entry", link.EntryID.ValueString()))
+			}
+		}
+		return result
+	}
+	return ParseContentValue(f.Content.ValueString())
+}
+
+// IsKnown reports whether the value of the field block is known
+func (f Field) IsKnown() bool {
+	switch {
+	case !f.EntryLink.IsNull():
+		return !f.EntryLink.IsUnknown()
+	case !f.AssetLink.IsNull():
+		return !f.AssetLink.IsUnknown()
+	case !f.Links.IsNull():
+		if f.Links.IsUnknown() {
+			return false
+		}
+		for _, link := range f.links() {
+			if link.EntryID.IsUnknown() || link.AssetID.IsUnknown() {
+				return false
+			}
+		}
+		return true
+	}
+	return !f.Content.IsUnknown()
+}
+
+func (f Field) links() []FieldLink {
+	var links []FieldLink
+	f.Links.ElementsAs(context.Background(), &links, false)
+	return links
+}
+
+// usesLinks reports whether the field block sets the value with one of the
+// link attributes instead of the content.
+func (f Field) usesLinks() bool {
+	return !f.EntryLink.IsNull() || !f.AssetLink.IsNull() || !f.Links.IsNull()
+}
+
+func linkValue(linkType string, id string) map[string]any {
+	return map[string]any{
+		"sys": map[string]any{
+			"type":     "Link",
+			"linkType": linkType,
+			"id":       id,
+		},
+	}
+}
+
+// newContentField creates a field block with the content set
+func newContentField(id string, locale string, content string) Field {
+	return Field{
+		ID:        types.StringValue(id),
+		Locale:    types.StringValue(locale),
+		Content:   NewFieldContentValue(content),
+		EntryLink: types.StringNull(),
+		AssetLink: types.StringNull(),
+		Links:     types.ListNull(fieldLinkType),
+	}
+}
+
+// newLinkField creates a field block with the link attributes set when the
+// value is a link or a list of links to entries and assets.
+func newLinkField(id string, locale string, value any) (Field, bool) {
+	field := newContentField(id, locale, "")
+	field.Content = FieldContent{StringValue: types.StringNull()}
+
+	if linkType, linkID, ok := parseLink(value); ok {
+		if linkType == "Entry" {
+			field.EntryLink = types.StringValue(linkID)
+		} else {
+			field.AssetLink = types.StringValue(linkID)
+		}
+		return field, true
+	}
+
+	items, ok := value.([]any)
+	if !ok || len(items) == 0 {
+		return field, false
+	}
+
+	links := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		linkType, linkID, ok := parseLink(item)
+		if !ok {
+			return field, false
+		}
+
+		entryID, assetID := types.StringNull(), types.StringNull()
+		if linkType == "Entry" {
+			entryID = types.StringValue(linkID)
+		} else {
+			assetID = types.StringValue(linkID)
+		}
+		links = append(links, types.ObjectValueMust(fieldLinkType.AttrTypes, map[string]attr.Value{
+			"entry_id": entryID,
+			"asset_id": assetID,
+		}))
+	}
+	field.Links = types.ListValueMust(fieldLinkType, links)
+	return field, true
+}
+
+// parseLink returns the link type and ID when the value is a link to an entry
+// or an asset without any other properties.
+func parseLink(value any) (string, string, bool) {
+	object, ok := toMap(value)
+	if !ok || len(object) != 1 {
+		return "", "", false
+	}
+
+	sys, ok := toMap(object["sys"])
+	if !ok || len(sys) != 3 || sys["type"] != "Link" {
+		return "", "", false
+	}
+
+	linkType, _ := sys["linkType"].(string)
+	id, _ := sys["id"].(string)
+	if (linkType != "Entry" && linkType != "Asset") || id == "" {
+		return "", "", false
+	}
+	return linkType, id, true
+}
+
+func toMap(value any) (map[string]any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		return v, true
+	case orderedmap.OrderedMap:
+		return v.Values(), true
+	case *orderedmap.OrderedMap:
+		return v.Values(), true
+	}
+	return nil, false
+}
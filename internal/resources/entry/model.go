@@ -13,22 +13,51 @@ import (
 
 // Entry is the main resource schema data
 type Entry struct {
-	ID            types.String `tfsdk:"id"`
-	EntryID       types.String `tfsdk:"entry_id"`
-	Version       types.Int64  `tfsdk:"version"`
-	SpaceID       types.String `tfsdk:"space_id"`
-	Environment   types.String `tfsdk:"environment"`
-	ContentTypeID types.String `tfsdk:"contenttype_id"`
-	Field         []Field      `tfsdk:"field"`
-	Published     types.Bool   `tfsdk:"published"`
-	Archived      types.Bool   `tfsdk:"archived"`
+	ID                  types.String  `tfsdk:"id"`
+	EntryID             types.String  `tfsdk:"entry_id"`
+	Version             types.Int64   `tfsdk:"version"`
+	SpaceID             types.String  `tfsdk:"space_id"`
+	Environment         types.String  `tfsdk:"environment"`
+	ContentTypeID       types.String  `tfsdk:"contenttype_id"`
+	Field               []Field       `tfsdk:"field"`
+	Fields              types.Dynamic `tfsdk:"fields"`
+	Tags                types.Set     `tfsdk:"tags"`
+	Concepts            types.Set     `tfsdk:"concepts"`
+	IgnoreUnmanagedTags types.Bool    `tfsdk:"ignore_unmanaged_tags"`
+	ValidateFields      types.Bool    `tfsdk:"validate_fields"`
+	Published           types.Bool    `tfsdk:"published"`
+	PublishedLocales    types.Set     `tfsdk:"published_locales"`
+	Archived            types.Bool    `tfsdk:"archived"`
+	DeletionPolicy      types.String  `tfsdk:"deletion_policy"`
+	LifecycleMode       types.String  `tfsdk:"lifecycle_mode"`
+	ConflictStrategy    types.String  `tfsdk:"conflict_strategy"`
+	PublishedVersion    types.Int64   `tfsdk:"published_version"`
+	Status              types.String  `tfsdk:"status"`
 }
 
+// The status of an entry, the same as the web app shows
+const (
+	StatusDraft     = "draft"
+	StatusChanged   = "changed"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+// The lifecycle modes of an entry. A seeded entry is created with the
+// configured fields, after that the fields are owned by the editors.
+const (
+	LifecycleModeManaged = "managed"
+	LifecycleModeSeed    = "seed"
+)
+
 // Field represents a content field in an Entry
 type Field struct {
-	ID      types.String `tfsdk:"id"`
-	Content types.String `tfsdk:"content"`
-	Locale  types.String `tfsdk:"locale"`
+	ID        types.String `tfsdk:"id"`
+	Content   FieldContent `tfsdk:"content"`
+	EntryLink types.String `tfsdk:"entry_link"`
+	AssetLink types.String `tfsdk:"asset_link"`
+	Links     types.List   `tfsdk:"links"`
+	Locale    types.String `tfsdk:"locale"`
 }
 
 // Import populates the Entry struct from an SDK entry object
@@ -41,18 +70,114 @@ func (e *Entry) Import(entry *sdk.Entry) {
 	e.ContentTypeID = types.StringValue(entry.Sys.ContentType.Sys.Id)
 	e.Published = types.BoolValue(entry.Sys.PublishedAt != nil)
 	e.Archived = types.BoolValue(entry.Sys.ArchivedAt != nil)
+	e.PublishedVersion = types.Int64PointerValue(entry.Sys.PublishedVersion)
+	e.Status = types.StringValue(entryStatus(entry.Sys))
+
+	// The published locales are only read back when they are managed
+	if e.PublishedLocales.IsNull() {
+		e.PublishedLocales = types.SetNull(types.StringType)
+	} else {
+		e.PublishedLocales = utils.PublishedLocales(entry.Sys.FieldStatus)
+	}
+	e.Tags = utils.MetadataTags(entry.Metadata)
+	e.Concepts = utils.MetadataConcepts(entry.Metadata)
+
+	// Keep the fields in the attribute that is used by the configuration
+	if e.Fields.IsNull() {
+		e.BuildFieldsFromAPIResponse(entry)
+		return
+	}
+
+	e.Field = []Field{}
+	fields := fieldsFromAPI(entry.Fields)
+	if !fieldsEqual(e.Fields, fields) {
+		e.Fields = fields
+	}
+}
+
+// entryStatus returns the status of the entry. Publishing increments the
+// version, so an entry that is not edited after it was published has the
+// version after the published version.
+func entryStatus(sys sdk.SystemPropertiesEntry) string {
+	switch {
+	case sys.ArchivedAt != nil:
+		return StatusArchived
+	case sys.PublishedAt == nil || sys.PublishedVersion == nil:
+		return StatusDraft
+	case sys.Version > *sys.PublishedVersion+1:
+		return StatusChanged
+	}
+	return StatusPublished
+}
+
+// Republishes returns whether the whole entry is published again, because it
+// was edited after it was published. With keepDraft the edits are kept as a
+// draft. When the published locales are managed only the locales that are
+// added or removed are published, so an edit to a locale that is not
+// published keeps the entry changed.
+func (e *Entry) Republishes(state *Entry, keepDraft bool) bool {
+	return e.Published.ValueBool() && e.PublishedLocales.IsNull() &&
+		state.Status.ValueString() == StatusChanged && !keepDraft
+}
+
+// CopyInputValues copies the values that are only known to the configuration
+// and leaves out the tags that are not managed when they are ignored. A seeded
+// entry keeps the configured fields, so changes by editors are not reverted.
+func (e *Entry) CopyInputValues(plan *Entry) {
+	e.IgnoreUnmanagedTags = plan.IgnoreUnmanagedTags
+	e.ValidateFields = plan.ValidateFields
+	e.DeletionPolicy = plan.DeletionPolicy
+	e.LifecycleMode = plan.LifecycleMode
+	e.ConflictStrategy = plan.ConflictStrategy
+	if plan.IgnoreUnmanagedTags.ValueBool() {
+		e.Tags = utils.ManagedTags(e.Tags, plan.Tags)
+	}
+	if plan.IsSeeded() {
+		e.Field = plan.Field
+		e.Fields = plan.Fields
+	}
+}
+
+// IsSeeded reports whether the fields are only set when the entry is created
+func (e *Entry) IsSeeded() bool {
+	return e.LifecycleMode.ValueString() == LifecycleModeSeed
+}
+
+// SeedChanged reports whether the configured fields of a seeded entry differ
+// from the fields it was seeded with, these changes are not sent to Contentful.
+func (e *Entry) SeedChanged(state *Entry) bool {
+	if !e.IsSeeded() || !state.IsSeeded() {
+		return false
+	}
+
+	values, complete := e.configuredValues()
+	if !complete {
+		return false
+	}
+	for _, value := range values {
+		if !value.known {
+			return false
+		}
+	}
 
-	e.BuildFieldsFromAPIResponse(entry)
+	return !sameDraft(sdk.EntryDraft{Fields: e.Draft().Fields}, sdk.EntryDraft{Fields: state.Draft().Fields})
 }
 
 // DraftForCreate creates an EntryCreate object for creating a new entry
 func (e *Entry) Draft() sdk.EntryDraft {
+	if !e.Fields.IsNull() {
+		return sdk.EntryDraft{
+			Fields:   draftFields(e.Fields),
+			Metadata: utils.MetadataDraft(e.Tags, e.Concepts),
+		}
+	}
+
 	fieldProperties := orderedmap.New()
 
 	for _, field := range e.Field {
 		fieldID := field.ID.ValueString()
 		locale := field.Locale.ValueString()
-		content := ParseContentValue(field.Content.ValueString())
+		content := field.Value()
 
 		prop, ok := fieldProperties.Get(fieldID)
 		if !ok {
@@ -64,7 +189,8 @@ func (e *Entry) Draft() sdk.EntryDraft {
 	}
 
 	return sdk.EntryDraft{
-		Fields: fieldProperties,
+		Fields:   fieldProperties,
+		Metadata: utils.MetadataDraft(e.Tags, e.Concepts),
 	}
 }
 
@@ -79,8 +205,17 @@ func ParseContentValue(value string) interface{} {
 	return utils.SortOrderedMapRecursively(content)
 }
 
-// BuildFieldsFromAPIResponse builds the Field array from API response
+// BuildFieldsFromAPIResponse builds the Field array from API response. Links to
+// entries and assets are set in the link attributes, unless the field block
+// used the content for them before.
 func (e *Entry) BuildFieldsFromAPIResponse(entry *sdk.Entry) {
+	usesContent := map[string]bool{}
+	for _, field := range e.Field {
+		if !field.usesLinks() {
+			usesContent[field.ID.ValueString()+"/"+field.Locale.ValueString()] = true
+		}
+	}
+
 	e.Field = []Field{}
 
 	// If no fields are present in the response, return early
@@ -98,6 +233,13 @@ func (e *Entry) BuildFieldsFromAPIResponse(entry *sdk.Entry) {
 		for _, locale := range subFields.Keys() {
 			content, _ := subFields.Get(locale)
 
+			if !usesContent[fieldID+"/"+locale] {
+				if field, ok := newLinkField(fieldID, locale, content); ok {
+					e.Field = append(e.Field, field)
+					continue
+				}
+			}
+
 			// Convert the content back to string representation for storage
 			contentStr := ""
 			switch v := content.(type) {
@@ -113,11 +255,7 @@ func (e *Entry) BuildFieldsFromAPIResponse(entry *sdk.Entry) {
 				}
 			}
 
-			e.Field = append(e.Field, Field{
-				ID:      types.StringValue(fieldID),
-				Locale:  types.StringValue(locale),
-				Content: types.StringValue(contentStr),
-			})
+			e.Field = append(e.Field, newContentField(fieldID, locale, contentStr))
 		}
 	}
 }
@@ -6,14 +6,19 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
@@ -21,9 +26,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &entryResource{}
-	_ resource.ResourceWithConfigure   = &entryResource{}
-	_ resource.ResourceWithImportState = &entryResource{}
+	_ resource.Resource                   = &entryResource{}
+	_ resource.ResourceWithConfigure      = &entryResource{}
+	_ resource.ResourceWithImportState    = &entryResource{}
+	_ resource.ResourceWithValidateConfig = &entryResource{}
+	_ resource.ResourceWithUpgradeState   = &entryResource{}
+	_ resource.ResourceWithModifyPlan     = &entryResource{}
 )
 
 func NewEntryResource() resource.Resource {
@@ -33,6 +41,7 @@ func NewEntryResource() resource.Resource {
 // entryResource is the resource implementation.
 type entryResource struct {
 	client *sdk.ClientWithResponses
+	cache  *utils.Cache
 }
 
 func (e *entryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -42,6 +51,7 @@ func (e *entryResource) Metadata(_ context.Context, request resource.MetadataReq
 func (e *entryResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Description: "A Contentful Entry represents a piece of content in a space.",
+		Version:     1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -82,18 +92,99 @@ func (e *entryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"fields": schema.DynamicAttribute{
+				Optional: true,
+				Description: "Content fields as a map of field IDs to a map of locale codes to the value, for example " +
+					"`{ title = { \"en-US\" = \"Hello\" } }`. Numbers, booleans, lists and objects keep their type, so " +
+					"rich text and JSON fields don't need jsonencode. Can't be combined with field blocks.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the tags of the entry. When not set, the tags that are managed in the web app are kept",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"concepts": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the taxonomy concepts of the entry. When not set, the concepts that are managed in the web app are kept",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_unmanaged_tags": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only manage the tags that are set in tags, tags that are added in the web app are kept and don't show up in the plan",
+			},
+			"validate_fields": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to check the fields against the content type and the locales of the environment during plan, " +
+					"defaults to true. Fields and locales that are added in the same apply are accepted when their content type or " +
+					"locale resource is planned before the entry, like when the entry refers to it or depends on it",
+			},
 			"published": schema.BoolAttribute{
 				Required:    true,
 				Description: "Whether the entry is published",
 			},
+			"published_locales": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only publish these locales and unpublish the others, which needs locale based publishing in the space. " +
+					"When not set, all locales are published together",
+			},
 			"archived": schema.BoolAttribute{
 				Required:    true,
 				Description: "Whether the entry is archived",
 			},
+			"deletion_policy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(utils.DeletionPolicyDelete),
+				Description: "What happens to the entry when the resource is destroyed: delete it, archive it, unpublish it or " +
+					"retain it unchanged. Defaults to delete",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.DeletionPolicies...),
+				},
+			},
+			"lifecycle_mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(LifecycleModeManaged),
+				Description: "Set to seed to only set the fields when the entry is created, after that changes by editors are " +
+					"kept and changes to the configured fields are ignored. The tags, publishing and archiving are still " +
+					"managed. Defaults to managed",
+				Validators: []validator.String{
+					stringvalidator.OneOf(LifecycleModeManaged, LifecycleModeSeed),
+				},
+			},
+			"conflict_strategy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(ConflictStrategyFail),
+				Description: "What happens when the entry was changed in Contentful between the plan and the apply: fail with the " +
+					"fields that were changed, overwrite the changes with the configured fields, or merge the fields that " +
+					"Terraform changes into the entry in Contentful. Defaults to fail",
+				Validators: []validator.String{
+					stringvalidator.OneOf(ConflictStrategyFail, ConflictStrategyOverwrite, ConflictStrategyMerge),
+				},
+			},
+			"published_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version of the entry that was published last",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				Description: "The status of the entry: draft, changed, published or archived. An entry is changed when it was " +
+					"edited after it was published, when published is true it is published again",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"field": schema.ListNestedBlock{
-				Description: "Content fields",
+				Description: "Content fields, use the fields attribute to set the fields without depending on the order of the blocks",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -101,8 +192,45 @@ func (e *entryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							Description: "Field ID",
 						},
 						"content": schema.StringAttribute{
-							Required:    true,
-							Description: "Field content. If the field type is Richtext the content can be passed as stringified JSON.",
+							Optional:    true,
+							CustomType:  FieldContentType{},
+							Description: "Field content. If the field type is Richtext the content can be passed as stringified JSON, which is compared by value so formatting differences don't cause a diff. Exactly one of content, entry_link, asset_link and links needs to be set",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("entry_link"),
+									path.MatchRelative().AtParent().AtName("asset_link"),
+									path.MatchRelative().AtParent().AtName("links"),
+								),
+							},
+						},
+						"entry_link": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the entry to link to, for a field of type Link",
+						},
+						"asset_link": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the asset to link to, for a field of type Link",
+						},
+						"links": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Entries and assets to link to in this order, for a field of type Array of links",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"entry_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "ID of the entry to link to, exactly one of entry_id and asset_id needs to be set",
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("asset_id"),
+											),
+										},
+									},
+									"asset_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "ID of the asset to link to",
+									},
+								},
+							},
 						},
 						"locale": schema.StringAttribute{
 							Required:    true,
@@ -122,6 +250,153 @@ func (e *entryResource) Configure(_ context.Context, request resource.ConfigureR
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.cache = data.Cache
+}
+
+func (e *entryResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var config Entry
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.Field) > 0 && !config.Fields.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("fields"),
+			"Conflicting entry fields",
+			"Set the fields of the entry with either field blocks or the fields attribute, not both",
+		)
+		return
+	}
+
+	response.Diagnostics.Append(validateFields(config.Fields)...)
+
+	if !config.PublishedLocales.IsNull() && !config.Published.IsUnknown() && !config.Published.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("published_locales"),
+			"Invalid published locales",
+			"published_locales can only be set when published is true",
+		)
+	}
+}
+
+func (e *entryResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Show what happens to the content when the entry is destroyed
+	if request.Plan.Raw.IsNull() {
+		var state Entry
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		summary, detail, ok := utils.DeletionWarning("Entry", state.ID.ValueString(), state.DeletionPolicy.ValueString(), state.Published.ValueBool())
+		if ok {
+			response.Diagnostics.AddWarning(summary, detail)
+		}
+		return
+	}
+
+	var plan Entry
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state Entry
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An entry that was edited after it was published is published again,
+		// so the delivery API serves the configured content. The changes to a
+		// seeded entry are published by the editors.
+		if !plan.Archived.ValueBool() && plan.Republishes(&state, plan.IsSeeded()) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("status"), StatusPublished)...)
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("published_version"), types.Int64Unknown())...)
+		}
+
+		if plan.SeedChanged(&state) {
+			response.Diagnostics.AddWarning(
+				"Entry fields are not updated",
+				fmt.Sprintf("The fields of entry %s changed in the configuration, but the entry is seeded so the fields in Contentful are kept", state.ID.ValueString()),
+			)
+		}
+	}
+
+	if !plan.ValidateFields.IsNull() && !plan.ValidateFields.ValueBool() {
+		return
+	}
+	if plan.SpaceID.IsUnknown() || plan.Environment.IsUnknown() || plan.ContentTypeID.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(e.validate(ctx, &plan, false)...)
+}
+
+// validate checks the plan against the content type and the locales. These are
+// cached for the whole plan, so when the check fails they are fetched again in
+// case they were changed earlier in the same apply.
+func (e *entryResource) validate(ctx context.Context, plan *Entry, refreshed bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	spaceID := plan.SpaceID.ValueString()
+	environment := plan.Environment.ValueString()
+	contentTypeKey := utils.ContentTypeKey(spaceID, environment, plan.ContentTypeID.ValueString())
+	localesKey := "locales/" + spaceID + "/" + environment
+
+	contentType, err := utils.CachedContentType(ctx, e.client, e.cache, spaceID, environment, plan.ContentTypeID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error validating entry",
+			"Could not read content type: "+err.Error(),
+		)
+		return diags
+	}
+	if contentType == nil {
+		// The content type is created in the same apply
+		return diags
+	}
+
+	locales, err := utils.Cached(e.cache, localesKey, func() ([]sdk.Locale, error) {
+		resp, err := e.client.GetAllLocalesWithResponse(ctx, spaceID, environment, &sdk.GetAllLocalesParams{
+			Limit: utils.Pointer(1000),
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+		if resp.JSON200.Items == nil {
+			return nil, nil
+		}
+		return *resp.JSON200.Items, nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error validating entry",
+			"Could not read locales: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Fields and locales that are added in the same apply can be used, when the
+	// content type or the locale is planned before the entry
+	fieldIDs, planned := utils.PlannedContentType(e.cache, spaceID, environment, plan.ContentTypeID.ValueString())
+	if planned && fieldIDs == nil {
+		return diags
+	}
+	contentType, locales = plan.WithPlanned(contentType, locales, fieldIDs, func(code string) bool {
+		return e.cache.Has(utils.PlannedLocaleKey(spaceID, environment, code))
+	})
+
+	diags = plan.Validate(contentType, locales)
+	if diags.HasError() && !refreshed {
+		e.cache.Forget(contentTypeKey)
+		e.cache.Forget(localesKey)
+		return e.validate(ctx, plan, true)
+	}
+	return diags
 }
 
 func (e *entryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -167,17 +442,18 @@ func (e *entryResource) Create(ctx context.Context, request resource.CreateReque
 	}
 
 	// Map response to state
-	state := Entry{}
+	state := Entry{Field: plan.Field, Fields: plan.Fields}
 	state.Import(entry)
 
 	// Set entry state (published/archived)
-	if err := e.setEntryState(ctx, &state, &plan); err != nil {
+	if err := e.setEntryState(ctx, &state, &plan, false); err != nil {
 		response.Diagnostics.AddError(
 			"Error setting entry state",
 			err.Error(),
 		)
 		return
 	}
+	state.CopyInputValues(&plan)
 
 	// Set state
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
@@ -210,40 +486,95 @@ func (e *entryResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
-	// Create update parameters with version
-	params := &sdk.UpdateEntryParams{
-		XContentfulVersion: state.Version.ValueInt64(),
+	// Nothing needs to be sent when only the way the fields are set changed,
+	// for example after moving from field blocks to the fields attribute
+	draft := plan.Draft()
+	prior := state.Draft()
+	changed := !sameDraft(draft, prior)
+
+	// The fields of a seeded entry are owned by the editors, so only the
+	// metadata is updated with the current fields. Changes of the editors
+	// that are not published yet are not published by Terraform either.
+	keepDraft := plan.IsSeeded() && state.Status.ValueString() == StatusChanged
+	if plan.IsSeeded() {
+		changed = !sameDraft(sdk.EntryDraft{Metadata: draft.Metadata}, sdk.EntryDraft{Metadata: prior.Metadata})
 	}
 
-	// Update the entry
-	draft := plan.Draft()
-	resp, err := e.client.UpdateEntryWithResponse(
-		ctx,
-		plan.SpaceID.ValueString(),
-		plan.Environment.ValueString(),
-		plan.ID.ValueString(),
-		params,
-		draft,
-	)
+	// Keep the fields in the attribute that is used by the configuration
+	state.Field = plan.Field
+	state.Fields = plan.Fields
+
+	// After a conflict the state keeps the configured fields, the changes of
+	// the editors that were merged are read back by the next refresh
+	conflict := false
+	if changed {
+		if plan.IsSeeded() {
+			if err := e.currentFields(ctx, &state, &draft); err != nil {
+				response.Diagnostics.AddError(
+					"Error updating entry",
+					"Could not read the current fields of the entry: "+err.Error(),
+				)
+				return
+			}
+		}
 
-	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
-		response.Diagnostics.AddError(
-			"Error updating entry",
-			"Could not update entry: "+err.Error(),
+		if plan.IgnoreUnmanagedTags.ValueBool() {
+			if err := e.mergeUnmanagedTags(ctx, &state, &draft); err != nil {
+				response.Diagnostics.AddError(
+					"Error updating entry",
+					"Could not read the current tags of the entry: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		// Create update parameters with version
+		params := &sdk.UpdateEntryParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		}
+
+		// Update the entry
+		resp, err := e.client.UpdateEntryWithResponse(
+			ctx,
+			plan.SpaceID.ValueString(),
+			plan.Environment.ValueString(),
+			plan.ID.ValueString(),
+			params,
+			draft,
 		)
-		return
-	}
 
-	state.Import(resp.JSON200)
+		if err == nil && resp.StatusCode() == http.StatusConflict {
+			entry, diags := e.resolveConflict(ctx, &state, &plan, prior, draft)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			state.Import(entry)
+			conflict = true
+		} else if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error updating entry",
+				"Could not update entry: "+err.Error(),
+			)
+			return
+		} else {
+			state.Import(resp.JSON200)
+		}
+	}
 
 	// Set entry state (published/archived)
-	if err := e.setEntryState(ctx, &state, &plan); err != nil {
+	if err := e.setEntryState(ctx, &state, &plan, keepDraft); err != nil {
 		response.Diagnostics.AddError(
 			"Error setting entry state",
 			err.Error(),
 		)
 		return
 	}
+	state.CopyInputValues(&plan)
+	if conflict {
+		state.Field = plan.Field
+		state.Fields = plan.Fields
+	}
 
 	// Set state
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
@@ -257,6 +588,12 @@ func (e *entryResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
+	policy := state.DeletionPolicy.ValueString()
+	if policy == utils.DeletionPolicyRetain {
+		tflog.Info(ctx, fmt.Sprintf("Entry %s is kept because deletion_policy is retain", state.ID.ValueString()))
+		return
+	}
+
 	// Get latest version first to avoid conflicts
 	resp, err := e.client.GetEntryWithResponse(
 		ctx,
@@ -299,6 +636,31 @@ func (e *entryResource) Delete(ctx context.Context, request resource.DeleteReque
 		state.Import(resp.JSON200)
 	}
 
+	switch policy {
+	case utils.DeletionPolicyUnpublish:
+		return
+	case utils.DeletionPolicyArchive:
+		if state.Archived.ValueBool() {
+			return
+		}
+		resp, err := e.client.ArchiveEntryWithResponse(
+			ctx,
+			state.SpaceID.ValueString(),
+			state.Environment.ValueString(),
+			state.ID.ValueString(),
+			&sdk.ArchiveEntryParams{
+				XContentfulVersion: state.Version.ValueInt64(),
+			},
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error deleting entry",
+				"Could not archive entry: "+err.Error(),
+			)
+		}
+		return
+	}
+
 	// Create delete parameters with latest version
 	params := &sdk.DeleteEntryParams{
 		XContentfulVersion: int64(state.Version.ValueInt64()),
@@ -346,10 +708,13 @@ func (e *entryResource) ImportState(ctx context.Context, request resource.Import
 	environment := idParts[2]
 
 	entry := Entry{
-		ID:          types.StringValue(entryID),
-		EntryID:     types.StringValue(entryID),
-		SpaceID:     types.StringValue(spaceID),
-		Environment: types.StringValue(environment),
+		ID:               types.StringValue(entryID),
+		EntryID:          types.StringValue(entryID),
+		SpaceID:          types.StringValue(spaceID),
+		Environment:      types.StringValue(environment),
+		DeletionPolicy:   types.StringValue(utils.DeletionPolicyDelete),
+		LifecycleMode:    types.StringValue(LifecycleModeManaged),
+		ConflictStrategy: types.StringValue(ConflictStrategyFail),
 	}
 
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), entryID)...)
@@ -361,6 +726,8 @@ func (e *entryResource) ImportState(ctx context.Context, request resource.Import
 }
 
 func (e *entryResource) doRead(ctx context.Context, entry *Entry, state *tfsdk.State, d *diag.Diagnostics) {
+	oldState := *entry
+
 	resp, err := e.client.GetEntryWithResponse(
 		ctx,
 		entry.SpaceID.ValueString(),
@@ -396,13 +763,90 @@ func (e *entryResource) doRead(ctx context.Context, entry *Entry, state *tfsdk.S
 
 	// Map response to state
 	entry.Import(resp.JSON200)
+	entry.CopyInputValues(&oldState)
 
 	// Set state
 	d.Append(state.Set(ctx, entry)...)
 }
 
-// setEntryState handles publishing and archiving based on the desired state
-func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *Entry) error {
+// currentFields sets the fields of the entry in Contentful in the draft, and
+// the version they belong to in the state.
+func (e *entryResource) currentFields(ctx context.Context, state *Entry, draft *sdk.EntryDraft) error {
+	resp, err := e.client.GetEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+
+	draft.Fields = &resp.JSON200.Fields
+	state.Version = types.Int64Value(resp.JSON200.Sys.Version)
+	return nil
+}
+
+// mergeUnmanagedTags keeps the tags that were added outside of Terraform, they
+// are not in the state so the entry is read first.
+func (e *entryResource) mergeUnmanagedTags(ctx context.Context, state *Entry, draft *sdk.EntryDraft) error {
+	resp, err := e.client.GetEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+
+	utils.MergeUnmanagedTags(draft.Metadata, state.Tags, resp.JSON200.Metadata)
+	return nil
+}
+
+// publishLocales publishes the locales and unpublishes the other locales, the
+// entry is read first for the current status of the locales.
+func (e *entryResource) publishLocales(ctx context.Context, state *Entry, locales types.Set) error {
+	resp, err := e.client.GetEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+	state.Import(resp.JSON200)
+
+	body := utils.LocalePublishing(resp.JSON200.Sys.FieldStatus, locales)
+	if body == nil {
+		return nil
+	}
+
+	publishResp, err := e.client.PublishEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.PublishEntryParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+		utils.WithJSONBody(body),
+	)
+	if err := utils.CheckClientResponse(publishResp, err, http.StatusOK); err != nil {
+		return err
+	}
+	if publishResp.JSON200.Sys.FieldStatus == nil {
+		return fmt.Errorf("the published locales were not returned, locale based publishing needs to be enabled for the space")
+	}
+	state.Import(publishResp.JSON200)
+
+	return nil
+}
+
+// setEntryState handles publishing and archiving based on the desired state.
+// With keepDraft a changed entry is not published again.
+func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *Entry, keepDraft bool) error {
 
 	// Handle publishing state
 	isCurrentlyPublished := state.Published.ValueBool()
@@ -412,7 +856,19 @@ func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *E
 	isCurrentlyArchived := state.Archived.ValueBool()
 	shouldBeArchived := plan.Archived.ValueBool()
 
-	if shouldBePublished && !isCurrentlyPublished {
+	// An entry that is edited after it was published needs to be published again
+	isChanged := plan.Republishes(state, keepDraft)
+
+	// After publishing separate locales, all locales are published again when
+	// the locales are no longer set
+	wasLocaleScoped := !state.PublishedLocales.IsNull()
+	state.PublishedLocales = plan.PublishedLocales
+
+	if shouldBePublished && !plan.PublishedLocales.IsNull() {
+		if err := e.publishLocales(ctx, state, plan.PublishedLocales); err != nil {
+			return err
+		}
+	} else if shouldBePublished && (!isCurrentlyPublished || isChanged || wasLocaleScoped) {
 		resp, err := e.client.PublishEntryWithResponse(
 			ctx,
 			state.SpaceID.ValueString(),
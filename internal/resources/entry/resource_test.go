@@ -2,20 +2,29 @@ package entry_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/iancoleman/orderedmap"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/labd/terraform-provider-contentful/internal/acctest"
 	"github.com/labd/terraform-provider-contentful/internal/provider"
 	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 func TestParseContentValue_String(t *testing.T) {
@@ -30,6 +39,581 @@ func TestParseContentValue_Json(t *testing.T) {
 	assert.Equal(t, map[string]interface{}{"foo": "bar", "baz": []interface{}{float64(1), float64(2), float64(3)}}, parsed)
 }
 
+func TestFieldContent_SemanticEquals(t *testing.T) {
+	cases := []struct {
+		name     string
+		prior    string
+		new      string
+		expected bool
+	}{
+		{"same text", "Hello, World!", "Hello, World!", true},
+		{"different text", "Hello, World!", "Hello, World! ", false},
+		{"whitespace in json", `{"a": [1, 2]}`, `{"a":[1,2]}`, true},
+		{"key order", `{"nodeType": "document", "data": {}}`, `{"data":{},"nodeType":"document"}`, true},
+		{"number formatting", `{"a": 1.0}`, `{"a":1}`, true},
+		{"different json", `{"a": 1}`, `{"a": 2}`, false},
+		{"quoted text", `"Hello"`, "Hello", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			equal, diags := entry.NewFieldContentValue(c.prior).StringSemanticEquals(context.Background(), entry.NewFieldContentValue(c.new))
+			require.False(t, diags.HasError())
+			assert.Equal(t, c.expected, equal)
+		})
+	}
+}
+
+func TestEntryMetadata_RoundTrip(t *testing.T) {
+	response := &sdk.Entry{
+		Metadata: &sdk.ContentMetadata{
+			Tags: &[]sdk.SystemPropertiesReference{
+				{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Tag", Id: "campaign"}},
+			},
+			Concepts: &[]sdk.SystemPropertiesReference{
+				{Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "TaxonomyConcept", Id: "shoes"}},
+			},
+		},
+	}
+	response.Sys.Id = "mytestentry"
+	response.Sys.Environment = &sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "master"}}
+	response.Sys.ContentType = sdk.SystemPropertiesReference{Sys: sdk.SystemPropertiesLink{Id: "tf_test_1"}}
+
+	var state entry.Entry
+	state.Import(response)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("campaign")}), state.Tags)
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("shoes")}), state.Concepts)
+
+	draft := state.Draft()
+	assert.Equal(t, *response.Metadata, *draft.Metadata)
+}
+
+func TestEntryMetadata_Unknown(t *testing.T) {
+	plan := entry.Entry{
+		Tags:     types.SetUnknown(types.StringType),
+		Concepts: types.SetValueMust(types.StringType, []attr.Value{}),
+	}
+
+	// Sets that are not known yet are left out of the request
+	draft := plan.Draft()
+	assert.Nil(t, draft.Metadata.Tags)
+	assert.Equal(t, []sdk.SystemPropertiesReference{}, *draft.Metadata.Concepts)
+}
+
+func TestEntryCopyInputValues_IgnoreUnmanagedTags(t *testing.T) {
+	plan := entry.Entry{
+		Tags:                types.SetValueMust(types.StringType, []attr.Value{types.StringValue("campaign")}),
+		IgnoreUnmanagedTags: types.BoolValue(true),
+	}
+	state := entry.Entry{
+		Tags: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("campaign"),
+			types.StringValue("added-by-editor"),
+		}),
+	}
+
+	state.CopyInputValues(&plan)
+	assert.Equal(t, plan.Tags, state.Tags)
+	assert.True(t, state.IgnoreUnmanagedTags.ValueBool())
+
+	// All tags are kept when unmanaged tags are not ignored
+	state.Tags = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("added-by-editor")})
+	state.CopyInputValues(&entry.Entry{Tags: plan.Tags})
+	assert.Len(t, state.Tags.Elements(), 1)
+}
+
+func testFields() types.Dynamic {
+	return types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"title": types.ObjectType{AttrTypes: map[string]attr.Type{"en-US": types.StringType, "de-DE": types.StringType}},
+			"count": types.ObjectType{AttrTypes: map[string]attr.Type{"en-US": types.NumberType}},
+			"labels": types.ObjectType{AttrTypes: map[string]attr.Type{
+				"en-US": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+			}},
+		},
+		map[string]attr.Value{
+			"title": types.ObjectValueMust(
+				map[string]attr.Type{"en-US": types.StringType, "de-DE": types.StringType},
+				map[string]attr.Value{"en-US": types.StringValue("Hello"), "de-DE": types.StringValue("Hallo")},
+			),
+			"count": types.ObjectValueMust(
+				map[string]attr.Type{"en-US": types.NumberType},
+				map[string]attr.Value{"en-US": types.NumberValue(big.NewFloat(3))},
+			),
+			"labels": types.ObjectValueMust(
+				map[string]attr.Type{"en-US": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}}},
+				map[string]attr.Value{"en-US": types.TupleValueMust(
+					[]attr.Type{types.StringType, types.StringType},
+					[]attr.Value{types.StringValue("a"), types.StringValue("b")},
+				)},
+			),
+		},
+	))
+}
+
+// fieldsJSON returns the fields attribute the way it is sent to Contentful
+func fieldsJSON(t *testing.T, state entry.Entry) string {
+	data, err := json.Marshal(entry.FieldValues(state.Fields))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestEntryFields_Draft(t *testing.T) {
+	plan := entry.Entry{Fields: testFields()}
+
+	data, err := json.Marshal(plan.Draft().Fields)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"count": {"en-US": 3},
+		"labels": {"en-US": ["a", "b"]},
+		"title": {"de-DE": "Hallo", "en-US": "Hello"}
+	}`, string(data))
+}
+
+func TestEntryFields_LargeNumber(t *testing.T) {
+	large, _, err := big.ParseFloat("1e20", 10, 512, big.ToNearestEven)
+	require.NoError(t, err)
+
+	fields := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"amount": types.ObjectType{AttrTypes: map[string]attr.Type{"en-US": types.NumberType}}},
+		map[string]attr.Value{"amount": types.ObjectValueMust(
+			map[string]attr.Type{"en-US": types.NumberType},
+			map[string]attr.Value{"en-US": types.NumberValue(large)},
+		)},
+	))
+
+	// A whole number above the int64 range is not clamped
+	assert.JSONEq(t, `{"amount": {"en-US": 1e20}}`, fieldsJSON(t, entry.Entry{Fields: fields}))
+}
+
+func TestEntryFields_Import(t *testing.T) {
+	response := &sdk.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fields": {
+			"title": {"en-US": "Hello", "de-DE": "Hallo"},
+			"labels": {"en-US": ["a", "b"]},
+			"count": {"en-US": 3}
+		},
+		"sys": {
+			"id": "mytestentry",
+			"version": 2,
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "tf_test_1"}}
+		}
+	}`), response))
+
+	// The configured value is kept when the content is the same, so the types
+	// of the configuration don't cause a diff
+	state := entry.Entry{Fields: testFields()}
+	state.Import(response)
+	assert.True(t, state.Fields.Equal(testFields()))
+	assert.Empty(t, state.Field)
+
+	// Changes made in Contentful are read back
+	response.Fields.Set("count", *orderedmapWith("en-US", float64(4)))
+	state.Import(response)
+	assert.False(t, state.Fields.Equal(testFields()))
+	assert.Equal(t, int64(4), entry.FieldValues(state.Fields)["count"]["en-US"])
+
+	// Without the fields attribute the field blocks are used
+	blocks := entry.Entry{}
+	blocks.Import(response)
+	assert.True(t, blocks.Fields.IsNull())
+	assert.Len(t, blocks.Field, 4)
+}
+
+func TestEntrySeed(t *testing.T) {
+	response := &sdk.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fields": {
+			"title": {"en-US": "Edited", "de-DE": "Bearbeitet"},
+			"labels": {"en-US": ["a", "b"]},
+			"count": {"en-US": 3}
+		},
+		"sys": {
+			"id": "mytestentry",
+			"version": 5,
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "tf_test_1"}}
+		}
+	}`), response))
+
+	// The changes of editors are not read back into a seeded entry
+	seeded := entry.Entry{Fields: testFields(), LifecycleMode: types.StringValue(entry.LifecycleModeSeed)}
+	state := seeded
+	state.Import(response)
+	state.CopyInputValues(&seeded)
+	assert.True(t, state.Fields.Equal(testFields()))
+	assert.Equal(t, int64(5), state.Version.ValueInt64())
+
+	managed := entry.Entry{Fields: testFields(), LifecycleMode: types.StringValue(entry.LifecycleModeManaged)}
+	state = managed
+	state.Import(response)
+	state.CopyInputValues(&managed)
+	assert.False(t, state.Fields.Equal(testFields()))
+
+	// A change of the configured fields is reported, but only for a seeded entry
+	plan := seeded
+	plan.Fields = types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"title": types.MapType{ElemType: types.StringType}},
+		map[string]attr.Value{"title": types.MapValueMust(types.StringType, map[string]attr.Value{"en-US": types.StringValue("New")})},
+	))
+	assert.True(t, plan.SeedChanged(&seeded))
+	assert.False(t, seeded.SeedChanged(&seeded))
+	assert.False(t, managed.SeedChanged(&managed))
+
+	plan.Fields = types.DynamicUnknown()
+	assert.False(t, plan.SeedChanged(&seeded))
+}
+
+func TestEntryConflict(t *testing.T) {
+	fields := func(data string) *orderedmap.OrderedMap {
+		result := orderedmap.New()
+		require.NoError(t, json.Unmarshal([]byte(data), result))
+		return result
+	}
+
+	state := fields(`{"title": {"en-US": "Hello", "de-DE": "Hallo"}, "count": {"en-US": 3}}`)
+	plan := fields(`{"title": {"en-US": "Hello", "de-DE": "Hallo"}, "count": {"en-US": 4}}`)
+	remote := fields(`{"title": {"en-US": "Edited", "de-DE": "Hallo"}, "count": {"en-US": 3}, "labels": {"en-US": ["a"]}}`)
+
+	assert.Equal(t, []string{"labels.en-US", "title.en-US"}, entry.ChangedFields(state, remote))
+	assert.Empty(t, entry.ChangedFields(state, state))
+
+	// Only the count is changed by Terraform, the title of the editor is kept
+	data, err := json.Marshal(entry.MergeFields(remote, state, plan))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"count": {"en-US": 4},
+		"labels": {"en-US": ["a"]},
+		"title": {"de-DE": "Hallo", "en-US": "Edited"}
+	}`, string(data))
+
+	// A locale that Terraform removes is removed from the entry
+	plan = fields(`{"title": {"en-US": "Hello"}, "count": {"en-US": 3}}`)
+	data, err = json.Marshal(entry.MergeFields(remote, state, plan))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"count": {"en-US": 3},
+		"labels": {"en-US": ["a"]},
+		"title": {"en-US": "Edited"}
+	}`, string(data))
+}
+
+func TestEntryImport_Status(t *testing.T) {
+	cases := []struct {
+		name   string
+		sys    string
+		status string
+	}{
+		{"draft", `"version": 1`, entry.StatusDraft},
+		{"published", `"version": 3, "publishedVersion": 2, "publishedAt": "2026-10-18T10:00:00Z"`, entry.StatusPublished},
+		{"changed", `"version": 4, "publishedVersion": 2, "publishedAt": "2026-10-18T10:00:00Z"`, entry.StatusChanged},
+		{"archived", `"version": 5, "archivedAt": "2026-10-18T10:00:00Z"`, entry.StatusArchived},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &sdk.Entry{}
+			require.NoError(t, json.Unmarshal([]byte(`{
+				"fields": {},
+				"sys": {
+					"id": "mytestentry",
+					"space": {"sys": {"id": "space"}},
+					"environment": {"sys": {"id": "master"}},
+					"contentType": {"sys": {"id": "tf_test_1"}},
+					`+tc.sys+`
+				}
+			}`), response))
+
+			state := entry.Entry{Fields: types.DynamicNull()}
+			state.Import(response)
+			assert.Equal(t, tc.status, state.Status.ValueString())
+		})
+	}
+}
+
+func TestEntry_Republishes(t *testing.T) {
+	changed := &entry.Entry{Status: types.StringValue(entry.StatusChanged)}
+	published := &entry.Entry{Status: types.StringValue(entry.StatusPublished)}
+	plan := &entry.Entry{Published: types.BoolValue(true), PublishedLocales: types.SetNull(types.StringType)}
+
+	assert.True(t, plan.Republishes(changed, false))
+	assert.False(t, plan.Republishes(published, false))
+
+	// A seeded entry keeps the edits as a draft
+	assert.False(t, plan.Republishes(changed, true))
+
+	// An entry that is unpublished is not published again
+	plan.Published = types.BoolValue(false)
+	assert.False(t, plan.Republishes(changed, false))
+}
+
+func TestEntry_RepublishesPublishedLocales(t *testing.T) {
+	// A German translation is added to an entry of which only English is
+	// published, which makes the entry changed
+	response := &sdk.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fields": {},
+		"sys": {
+			"id": "mytestentry",
+			"version": 5,
+			"publishedVersion": 3,
+			"publishedAt": "2026-10-18T10:00:00Z",
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "tf_test_1"}},
+			"fieldStatus": {"*": {"en-US": "published", "de-DE": "draft"}}
+		}
+	}`), response))
+
+	locales := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("en-US")})
+	state := entry.Entry{Fields: types.DynamicNull(), PublishedLocales: locales}
+	state.Import(response)
+	require.Equal(t, entry.StatusChanged, state.Status.ValueString())
+
+	// Nothing is published, so the plan keeps the status and the versions
+	plan := &entry.Entry{Published: types.BoolValue(true), PublishedLocales: locales}
+	assert.Nil(t, utils.LocalePublishing(response.Sys.FieldStatus, plan.PublishedLocales))
+	assert.False(t, plan.Republishes(&state, false))
+
+	// Without managed locales the whole entry is published again
+	plan.PublishedLocales = types.SetNull(types.StringType)
+	assert.True(t, plan.Republishes(&state, false))
+}
+
+func TestEntryImport_PublishedLocales(t *testing.T) {
+	response := &sdk.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fields": {},
+		"sys": {
+			"id": "mytestentry",
+			"version": 4,
+			"publishedAt": "2026-10-18T10:00:00Z",
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "tf_test_1"}},
+			"fieldStatus": {"*": {"en-US": "published", "fr-FR": "changed", "de-DE": "draft"}}
+		}
+	}`), response))
+
+	// The locales are only read back when they are managed
+	state := entry.Entry{Fields: types.DynamicNull()}
+	state.Import(response)
+	assert.True(t, state.PublishedLocales.IsNull())
+
+	state.PublishedLocales = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("en-US")})
+	state.Import(response)
+	assert.True(t, state.PublishedLocales.Equal(types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("en-US"),
+		types.StringValue("fr-FR"),
+	})))
+}
+
+func TestEntryLinks_Draft(t *testing.T) {
+	plan := entry.Entry{
+		Fields: types.DynamicNull(),
+		Field: []entry.Field{
+			{ID: types.StringValue("author"), Locale: types.StringValue("en-US"), EntryLink: types.StringValue("john")},
+			{ID: types.StringValue("image"), Locale: types.StringValue("en-US"), AssetLink: types.StringValue("logo")},
+			{ID: types.StringValue("related"), Locale: types.StringValue("en-US"), Links: types.ListValueMust(
+				types.ObjectType{AttrTypes: map[string]attr.Type{"entry_id": types.StringType, "asset_id": types.StringType}},
+				[]attr.Value{
+					types.ObjectValueMust(
+						map[string]attr.Type{"entry_id": types.StringType, "asset_id": types.StringType},
+						map[string]attr.Value{"entry_id": types.StringValue("post"), "asset_id": types.StringNull()},
+					),
+					types.ObjectValueMust(
+						map[string]attr.Type{"entry_id": types.StringType, "asset_id": types.StringType},
+						map[string]attr.Value{"entry_id": types.StringNull(), "asset_id": types.StringValue("photo")},
+					),
+				},
+			)},
+		},
+	}
+
+	data, err := json.Marshal(plan.Draft().Fields)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"author": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "john"}}},
+		"image": {"en-US": {"sys": {"type": "Link", "linkType": "Asset", "id": "logo"}}},
+		"related": {"en-US": [
+			{"sys": {"type": "Link", "linkType": "Entry", "id": "post"}},
+			{"sys": {"type": "Link", "linkType": "Asset", "id": "photo"}}
+		]}
+	}`, string(data))
+}
+
+func TestEntryLinks_Import(t *testing.T) {
+	response := &sdk.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"fields": {
+			"author": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "john"}}},
+			"related": {"en-US": [
+				{"sys": {"type": "Link", "linkType": "Entry", "id": "post"}},
+				{"sys": {"type": "Link", "linkType": "Asset", "id": "photo"}}
+			]}
+		},
+		"sys": {
+			"id": "mytestentry",
+			"version": 2,
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "tf_test_1"}}
+		}
+	}`), response))
+
+	state := entry.Entry{Fields: types.DynamicNull()}
+	state.Import(response)
+	require.Len(t, state.Field, 2)
+	assert.Equal(t, "john", state.Field[0].EntryLink.ValueString())
+	assert.True(t, state.Field[0].Content.IsNull())
+	assert.Len(t, state.Field[1].Links.Elements(), 2)
+
+	// Links that were set with the content keep using the content
+	state.Field[0] = entry.Field{
+		ID:      types.StringValue("author"),
+		Locale:  types.StringValue("en-US"),
+		Content: entry.NewFieldContentValue(`{"sys": {"type": "Link", "linkType": "Entry", "id": "john"}}`),
+	}
+	state.Import(response)
+	assert.True(t, state.Field[0].EntryLink.IsNull())
+	assert.True(t, entry.ContentEqual(`{"sys": {"id": "john", "linkType": "Entry", "type": "Link"}}`, state.Field[0].Content.ValueString()))
+	assert.False(t, state.Field[1].Links.IsNull())
+}
+
+func testValidationContentType(t *testing.T) (*sdk.ContentType, []sdk.Locale) {
+	contentType := &sdk.ContentType{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "Test",
+		"fields": [
+			{"id": "title", "name": "Title", "type": "Symbol", "localized": true, "required": true},
+			{"id": "count", "name": "Count", "type": "Integer", "localized": false, "required": false},
+			{"id": "labels", "name": "Labels", "type": "Array", "localized": false, "required": false, "items": {"type": "Symbol"}},
+			{"id": "body", "name": "Body", "type": "RichText", "localized": false, "required": false, "validations": [
+				{"enabledNodeTypes": ["heading-1", "hyperlink"]},
+				{"enabledMarks": ["bold"]}
+			]}
+		],
+		"sys": {"id": "tf_test_1", "type": "ContentType", "version": 1}
+	}`), contentType))
+
+	locales := []sdk.Locale{
+		{Code: "en-US", Default: utils.Pointer(true)},
+		{Code: "de-DE", Default: utils.Pointer(false)},
+	}
+	return contentType, locales
+}
+
+func testValidationField(id, locale, content string) entry.Field {
+	return entry.Field{
+		ID:      types.StringValue(id),
+		Locale:  types.StringValue(locale),
+		Content: entry.NewFieldContentValue(content),
+	}
+}
+
+func TestEntryValidate_Fields(t *testing.T) {
+	contentType, locales := testValidationContentType(t)
+
+	plan := entry.Entry{Fields: testFields(), Published: types.BoolValue(true)}
+	assert.False(t, plan.Validate(contentType, locales).HasError())
+}
+
+func TestEntryValidate_Errors(t *testing.T) {
+	contentType, locales := testValidationContentType(t)
+
+	cases := []struct {
+		name    string
+		field   entry.Field
+		summary string
+		detail  string
+	}{
+		{"unknown field", testValidationField("titel", "en-US", "Hello"), "Unknown entry field", "the available fields are: title, count, labels, body"},
+		{"unknown locale", testValidationField("title", "fr-FR", "Bonjour"), "Unknown locale", "fr-FR"},
+		{"not localized", testValidationField("count", "de-DE", "3"), "Field is not localized", "default locale en-US"},
+		{"number as text", testValidationField("title", "en-US", "42"), "Invalid entry field value", "use jsonencode"},
+		{"fraction", testValidationField("count", "en-US", "1.5"), "Invalid entry field value", "needs a whole number"},
+		{"list item", testValidationField("labels", "en-US", `["a", 1]`), "Invalid entry field value", "invalid item 1"},
+		{"rich text", testValidationField("body", "en-US", "plain text"), "Invalid entry field value", "nodeType document"},
+		{"node type", testValidationField("body", "en-US", `{"nodeType": "document", "content": [{"nodeType": "heading-2", "content": []}]}`), "Invalid entry field value", "node type heading-2"},
+		{"mark", testValidationField("body", "en-US", `{"nodeType": "document", "content": [{"nodeType": "paragraph", "content": [{"nodeType": "text", "value": "a", "marks": [{"type": "italic"}]}]}]}`), "Invalid entry field value", "the enabled marks are: bold"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := entry.Entry{
+				Field:     []entry.Field{testValidationField("title", "en-US", "Hello"), tc.field},
+				Fields:    types.DynamicNull(),
+				Published: types.BoolValue(false),
+			}
+
+			diags := plan.Validate(contentType, locales)
+			require.Len(t, diags, 1)
+			assert.Equal(t, tc.summary, diags[0].Summary())
+			assert.Contains(t, diags[0].Detail(), tc.detail)
+			assert.Equal(t, path.Root("field").AtListIndex(1), diags[0].(diag.DiagnosticWithPath).Path())
+		})
+	}
+}
+
+func TestEntryValidate_Required(t *testing.T) {
+	contentType, locales := testValidationContentType(t)
+
+	plan := entry.Entry{
+		Field:     []entry.Field{testValidationField("title", "de-DE", "Hallo")},
+		Fields:    types.DynamicNull(),
+		Published: types.BoolValue(false),
+	}
+	assert.False(t, plan.Validate(contentType, locales).HasError())
+
+	// The default locale needs a value before the entry can be published
+	plan.Published = types.BoolValue(true)
+	diags := plan.Validate(contentType, locales)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Missing required entry field", diags[0].Summary())
+
+	// Nothing is reported while a field ID is not known yet
+	plan.Field = append(plan.Field, entry.Field{ID: types.StringUnknown(), Locale: types.StringValue("en-US")})
+	assert.False(t, plan.Validate(contentType, locales).HasError())
+}
+
+func TestEntryValidate_Planned(t *testing.T) {
+	contentType, locales := testValidationContentType(t)
+
+	plan := entry.Entry{
+		Field: []entry.Field{
+			testValidationField("title", "en-US", "Hello"),
+			testValidationField("subtitle", "en-US", "World"),
+			testValidationField("title", "fr-FR", "Bonjour"),
+		},
+		Fields:    types.DynamicNull(),
+		Published: types.BoolValue(false),
+	}
+	require.Len(t, plan.Validate(contentType, locales), 2)
+
+	// The field and the locale are added in the same apply
+	plannedContentType, plannedLocales := plan.WithPlanned(contentType, locales, []string{"title", "subtitle"}, func(code string) bool {
+		return code == "fr-FR"
+	})
+	assert.False(t, plan.Validate(plannedContentType, plannedLocales).HasError())
+	assert.Len(t, contentType.Fields, 4)
+
+	// Fields that are not planned are still reported
+	plan.Field = append(plan.Field, testValidationField("titel", "en-US", "Hello"))
+	diags := plan.Validate(plannedContentType, plannedLocales)
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Unknown entry field", diags[0].Summary())
+}
+
+func orderedmapWith(key string, value any) *orderedmap.OrderedMap {
+	result := orderedmap.New()
+	result.Set(key, value)
+	return result
+}
+
 func TestEntryResource_Basic(t *testing.T) {
 	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
 	resourceName := "contentful_entry.myentry"
@@ -50,6 +634,7 @@ func TestEntryResource_Basic(t *testing.T) {
 						assert.Equal(t, spaceID, entry.Sys.Space.Sys.Id)
 						assert.NotNil(t, entry.Sys.PublishedAt)
 					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "published"),
 				),
 			},
 			{
@@ -61,6 +646,7 @@ func TestEntryResource_Basic(t *testing.T) {
 						assert.Equal(t, spaceID, entry.Sys.Space.Sys.Id)
 						assert.Nil(t, entry.Sys.PublishedAt)
 					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "draft"),
 				),
 			},
 			{
@@ -82,6 +668,43 @@ func TestEntryResource_Basic(t *testing.T) {
 	})
 }
 
+func TestEntryResource_Fields(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry.myentry"
+	var version int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulEntryDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEntryFieldsConfig(spaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "field.#", "0"),
+					testAccCheckContentfulEntryExists(t, resourceName, func(t *testing.T, entry *sdk.Entry) {
+						field3, _ := entry.Fields.Get("field3")
+						assert.NotNil(t, field3)
+						version = entry.Sys.Version
+					}),
+				),
+			},
+			{
+				// Moving to field blocks with the same content doesn't update the entry
+				Config: testEntryConfig(spaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "fields"),
+					testAccCheckContentfulEntryExists(t, resourceName, func(t *testing.T, entry *sdk.Entry) {
+						assert.Equal(t, version, entry.Sys.Version)
+					}),
+				),
+			},
+		},
+	})
+}
+
 type assertFunc func(*testing.T, *sdk.Entry)
 
 func testAccCheckContentfulEntryExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
@@ -321,3 +944,79 @@ resource "contentful_entry" "myentry" {
 }
 `, spaceID, spaceID)
 }
+
+func testEntryFieldsConfig(spaceID string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  name = "tf_test_1"
+  environment = "master-2026-02-20"
+  description = "Terraform Acc Test Content Type"
+  display_field = "field1"
+
+  fields = [
+		{
+			disabled  = false
+			id        = "field1"
+			localized = false
+			name      = "Field 1"
+			omitted   = false
+			required  = true
+			type      = "Text"
+		},
+		{
+			disabled  = false
+			id        = "field2"
+			localized = false
+			name      = "Field 2"
+			omitted   = false
+			required  = true
+			type      = "Text"
+		},
+		{
+			id       = "field3"
+			name     = "Field 3"
+			type     = "RichText"
+		}
+	]
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id = "mytestentry"
+  space_id = "%s"
+  environment = "master-2026-02-20"
+  contenttype_id = "tf_test_1"
+  fields = {
+    field1 = {
+      "en-US" = "Hello, World!"
+    }
+    field2 = {
+      "en-US" = "Bacon is healthy!"
+    }
+    field3 = {
+      "en-US" = {
+        data = {}
+        content = [
+          {
+            nodeType = "paragraph"
+            content = [
+              {
+                nodeType = "text"
+                marks = []
+                value = "This is another paragraph."
+                data = {}
+              },
+            ]
+            data = {}
+          }
+        ]
+        nodeType = "document"
+      }
+    }
+  }
+  published = true
+  archived  = false
+  depends_on = [contentful_contenttype.mycontenttype]
+}
+`, spaceID, spaceID)
+}
@@ -0,0 +1,126 @@
+package entry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// entryV0 is the schema data of version 0, which only stored the fields as a
+// list of field blocks.
+type entryV0 struct {
+	ID                  types.String `tfsdk:"id"`
+	EntryID             types.String `tfsdk:"entry_id"`
+	Version             types.Int64  `tfsdk:"version"`
+	SpaceID             types.String `tfsdk:"space_id"`
+	Environment         types.String `tfsdk:"environment"`
+	ContentTypeID       types.String `tfsdk:"contenttype_id"`
+	Field               []fieldV0    `tfsdk:"field"`
+	Tags                types.Set    `tfsdk:"tags"`
+	Concepts            types.Set    `tfsdk:"concepts"`
+	IgnoreUnmanagedTags types.Bool   `tfsdk:"ignore_unmanaged_tags"`
+	Published           types.Bool   `tfsdk:"published"`
+	Archived            types.Bool   `tfsdk:"archived"`
+}
+
+// fieldV0 is a field block of version 0, which only had the content
+type fieldV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Content FieldContent `tfsdk:"content"`
+	Locale  types.String `tfsdk:"locale"`
+}
+
+func schemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                    schema.StringAttribute{Computed: true},
+			"entry_id":              schema.StringAttribute{Required: true},
+			"version":               schema.Int64Attribute{Computed: true},
+			"space_id":              schema.StringAttribute{Required: true},
+			"environment":           schema.StringAttribute{Required: true},
+			"contenttype_id":        schema.StringAttribute{Required: true},
+			"tags":                  schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"concepts":              schema.SetAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"ignore_unmanaged_tags": schema.BoolAttribute{Optional: true},
+			"published":             schema.BoolAttribute{Required: true},
+			"archived":              schema.BoolAttribute{Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			"field": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id":      schema.StringAttribute{Required: true},
+						"content": schema.StringAttribute{Required: true, CustomType: FieldContentType{}},
+						"locale":  schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *entryResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: schemaV0(),
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var prior entryV0
+				response.Diagnostics.Append(request.State.Get(ctx, &prior)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				response.Diagnostics.Append(response.State.Set(ctx, upgradeV0(&prior))...)
+			},
+		},
+	}
+}
+
+// upgradeV0 moves the field blocks of version 0 into the fields attribute,
+// the content is parsed the same way as it was sent to Contentful.
+func upgradeV0(prior *entryV0) *Entry {
+	entry := &Entry{
+		ID:                  prior.ID,
+		EntryID:             prior.EntryID,
+		Version:             prior.Version,
+		SpaceID:             prior.SpaceID,
+		Environment:         prior.Environment,
+		ContentTypeID:       prior.ContentTypeID,
+		Field:               []Field{},
+		Fields:              types.DynamicNull(),
+		Tags:                prior.Tags,
+		Concepts:            prior.Concepts,
+		IgnoreUnmanagedTags: prior.IgnoreUnmanagedTags,
+		ValidateFields:      types.BoolNull(),
+		Published:           prior.Published,
+		PublishedLocales:    types.SetNull(types.StringType),
+		Archived:            prior.Archived,
+		DeletionPolicy:      types.StringValue(utils.DeletionPolicyDelete),
+		LifecycleMode:       types.StringValue(LifecycleModeManaged),
+		ConflictStrategy:    types.StringValue(ConflictStrategyFail),
+		PublishedVersion:    types.Int64Null(),
+		Status:              types.StringNull(),
+	}
+
+	if len(prior.Field) == 0 {
+		return entry
+	}
+
+	fields := orderedmap.New()
+	for _, field := range prior.Field {
+		locales, ok := fields.Get(field.ID.ValueString())
+		if !ok {
+			locales = map[string]any{}
+			fields.Set(field.ID.ValueString(), locales)
+		}
+		locales.(map[string]any)[field.Locale.ValueString()] = ParseContentValue(field.Content.ValueString())
+	}
+	entry.Fields = fieldsFromAPI(*fields)
+
+	return entry
+}
@@ -0,0 +1,76 @@
+package entry_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
+)
+
+// stateV0 is a state written by a provider version that only supported field
+// blocks.
+const stateV0 = `{
+  "id": "mytestentry",
+  "entry_id": "mytestentry",
+  "version": 3,
+  "space_id": "space",
+  "environment": "master",
+  "contenttype_id": "tf_test_1",
+  "published": true,
+  "archived": false,
+  "field": [
+    {"id": "field1", "locale": "en-US", "content": "Hello, World!"},
+    {"id": "field1", "locale": "de-DE", "content": "Hallo Welt!"},
+    {"id": "count", "locale": "en-US", "content": "3"},
+    {"id": "body", "locale": "en-US", "content": "{\"nodeType\": \"document\", \"content\": []}"}
+  ]
+}`
+
+func TestEntryUpgradeState_V0(t *testing.T) {
+	ctx := context.Background()
+	r := entry.NewEntryResource()
+	upgrader := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[0]
+
+	raw, err := (&tfprotov6.RawState{JSON: []byte(stateV0)}).UnmarshalWithOpts(
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	require.NoError(t, err)
+
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	request := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: raw},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var state entry.Entry
+	require.False(t, response.State.Get(ctx, &state).HasError())
+
+	assert.Equal(t, "mytestentry", state.ID.ValueString())
+	assert.Equal(t, int64(3), state.Version.ValueInt64())
+	assert.Equal(t, "delete", state.DeletionPolicy.ValueString())
+	assert.Equal(t, "managed", state.LifecycleMode.ValueString())
+	assert.Equal(t, "fail", state.ConflictStrategy.ValueString())
+	assert.Empty(t, state.Field)
+	assert.JSONEq(t, `{
+		"field1": {"en-US": "Hello, World!", "de-DE": "Hallo Welt!"},
+		"count": {"en-US": 3},
+		"body": {"en-US": {"content": [], "nodeType": "document"}}
+	}`, fieldsJSON(t, state))
+}
@@ -0,0 +1,409 @@
+package entry
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// fieldValue is the value of a field for a single locale in the
+// configuration, with the path that errors about the value are reported on.
+type fieldValue struct {
+	fieldID string
+	locale  string
+	value   any
+	known   bool
+	// content is set when the value is parsed from the content of a field
+	// block
+	content bool
+	path    path.Path
+}
+
+// configuredValues returns the values of the field blocks or of the fields
+// attribute. complete is false when some field IDs or locales are not known
+// yet, so it can't be told which fields are missing.
+func (e *Entry) configuredValues() (values []fieldValue, complete bool) {
+	complete = true
+
+	if e.Fields.IsNull() {
+		for i, field := range e.Field {
+			if field.ID.IsUnknown() || field.Locale.IsUnknown() {
+				complete = false
+				continue
+			}
+			value := fieldValue{
+				fieldID: field.ID.ValueString(),
+				locale:  field.Locale.ValueString(),
+				known:   field.IsKnown(),
+				content: !field.usesLinks(),
+				path:    path.Root("field").AtListIndex(i),
+			}
+			if value.known {
+				value.value = normalize(field.Value())
+			}
+			values = append(values, value)
+		}
+		return values, complete
+	}
+
+	if e.Fields.IsUnknown() || e.Fields.IsUnderlyingValueUnknown() {
+		return nil, false
+	}
+
+	fields := elements(e.Fields.UnderlyingValue())
+	for _, fieldID := range sortedKeys(fields) {
+		if fields[fieldID].IsUnknown() {
+			complete = false
+			continue
+		}
+
+		locales := elements(fields[fieldID])
+		for _, locale := range sortedKeys(locales) {
+			value := fieldValue{
+				fieldID: fieldID,
+				locale:  locale,
+				known:   fullyKnown(locales[locale]),
+				path:    path.Root("fields").AtMapKey(fieldID).AtMapKey(locale),
+			}
+			if locales[locale].IsNull() {
+				continue
+			}
+			if value.known {
+				value.value = normalize(plainValue(locales[locale]))
+			}
+			values = append(values, value)
+		}
+	}
+	return values, complete
+}
+
+// WithPlanned adds the fields and the locales that the entry uses and that are
+// planned in this run, but don't exist in Contentful yet, to the content type
+// and the locales. Only the IDs of these fields are known, so their values are
+// not checked.
+func (e *Entry) WithPlanned(contentType *sdk.ContentType, locales []sdk.Locale, fieldIDs []string, localePlanned func(code string) bool) (*sdk.ContentType, []sdk.Locale) {
+	fields := map[string]bool{}
+	for _, field := range contentType.Fields {
+		fields[field.Id] = true
+	}
+	localeCodes := map[string]bool{}
+	for _, locale := range locales {
+		localeCodes[locale.Code] = true
+	}
+
+	planned := *contentType
+	planned.Fields = slices.Clone(contentType.Fields)
+	for _, id := range fieldIDs {
+		if !fields[id] {
+			fields[id] = true
+			planned.Fields = append(planned.Fields, sdk.Field{Id: id, Localized: true})
+		}
+	}
+
+	values, _ := e.configuredValues()
+	plannedLocales := slices.Clone(locales)
+	for _, value := range values {
+		if !localeCodes[value.locale] && localePlanned(value.locale) {
+			localeCodes[value.locale] = true
+			plannedLocales = append(plannedLocales, sdk.Locale{Code: value.locale})
+		}
+	}
+	return &planned, plannedLocales
+}
+
+// Validate checks the configured fields against the content type and the
+// locales of the environment. Values that are not known yet are skipped.
+func (e *Entry) Validate(contentType *sdk.ContentType, locales []sdk.Locale) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := map[string]sdk.Field{}
+	fieldIDs := make([]string, 0, len(contentType.Fields))
+	for _, field := range contentType.Fields {
+		fields[field.Id] = field
+		fieldIDs = append(fieldIDs, field.Id)
+	}
+
+	defaultLocale := ""
+	localeCodes := map[string]bool{}
+	for _, locale := range locales {
+		localeCodes[locale.Code] = true
+		if locale.Default != nil && *locale.Default {
+			defaultLocale = locale.Code
+		}
+	}
+
+	values, complete := e.configuredValues()
+	present := map[string]bool{}
+	for _, value := range values {
+		field, ok := fields[value.fieldID]
+		if !ok {
+			diags.AddAttributeError(
+				value.path,
+				"Unknown entry field",
+				fmt.Sprintf("Content type %s has no field %s, the available fields are: %s",
+					contentType.Sys.Id, value.fieldID, strings.Join(fieldIDs, ", ")),
+			)
+			continue
+		}
+
+		if !localeCodes[value.locale] {
+			diags.AddAttributeError(
+				value.path,
+				"Unknown locale",
+				fmt.Sprintf("Locale %s of field %s does not exist in the environment", value.locale, value.fieldID),
+			)
+			continue
+		}
+
+		if !field.Localized && defaultLocale != "" && value.locale != defaultLocale {
+			diags.AddAttributeError(
+				value.path,
+				"Field is not localized",
+				fmt.Sprintf("Field %s is not localized, it can only be set for the default locale %s", value.fieldID, defaultLocale),
+			)
+			continue
+		}
+
+		if value.known {
+			problem := checkFieldValue(string(field.Type), field.Items, value.value)
+			if problem == "" && field.Type == "RichText" {
+				problem = checkRichText(field.Validations, value.value)
+			}
+			if problem != "" {
+				if value.content && isTextType(string(field.Type)) {
+					problem += ". The content of a field block is sent as JSON when it can be parsed as JSON, " +
+						"use jsonencode to send it as text"
+				}
+				diags.AddAttributeError(
+					value.path,
+					"Invalid entry field value",
+					fmt.Sprintf("Field %s of type %s %s", value.fieldID, field.Type, problem),
+				)
+			}
+		}
+
+		if value.locale == defaultLocale {
+			present[value.fieldID] = true
+		}
+	}
+
+	// Contentful only checks the required fields when the entry is published
+	if !e.Published.ValueBool() || !complete || defaultLocale == "" {
+		return diags
+	}
+
+	for _, field := range contentType.Fields {
+		if !field.Required || present[field.Id] {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("published"),
+			"Missing required entry field",
+			fmt.Sprintf("Field %s of content type %s is required to publish the entry, set a value for the default locale %s",
+				field.Id, contentType.Sys.Id, defaultLocale),
+		)
+	}
+
+	return diags
+}
+
+func isTextType(fieldType string) bool {
+	return fieldType == string(sdk.FieldTypeSymbol) || fieldType == string(sdk.FieldTypeText) || fieldType == string(sdk.FieldTypeDate)
+}
+
+// checkFieldValue describes why the value can't be stored in a field of the
+// type, or returns an empty string when it can.
+func checkFieldValue(fieldType string, items *sdk.FieldItem, value any) string {
+	switch fieldType {
+	case string(sdk.FieldTypeSymbol), string(sdk.FieldTypeText), string(sdk.FieldTypeDate):
+		if _, ok := value.(string); !ok {
+			return "needs text, got " + describe(value)
+		}
+	case string(sdk.FieldTypeInteger):
+		if number, ok := value.(float64); !ok || number != math.Trunc(number) {
+			return "needs a whole number, got " + describe(value)
+		}
+	case string(sdk.FieldTypeNumber):
+		if _, ok := value.(float64); !ok {
+			return "needs a number, got " + describe(value)
+		}
+	case string(sdk.FieldTypeBoolean):
+		if _, ok := value.(bool); !ok {
+			return "needs a boolean, got " + describe(value)
+		}
+	case string(sdk.FieldTypeLocation):
+		object, ok := value.(map[string]any)
+		_, lat := object["lat"].(float64)
+		_, lon := object["lon"].(float64)
+		if !ok || !lat || !lon {
+			return "needs an object with a lat and lon number, got " + describe(value)
+		}
+	case "RichText":
+		if object, ok := value.(map[string]any); !ok || object["nodeType"] != "document" {
+			return "needs a rich text object with nodeType document, got " + describe(value)
+		}
+	case string(sdk.FieldTypeLink), "ResourceLink":
+		if !isLink(value) {
+			return "needs a link object with sys, got " + describe(value)
+		}
+	case string(sdk.FieldTypeArray):
+		list, ok := value.([]any)
+		if !ok {
+			return "needs a list, got " + describe(value)
+		}
+		itemType := ""
+		if items != nil {
+			itemType, _ = items.Discriminator()
+		}
+		for i, item := range list {
+			if itemType != string(sdk.FieldTypeSymbol) && itemType != string(sdk.FieldTypeLink) {
+				break
+			}
+			if problem := checkFieldValue(itemType, nil, item); problem != "" {
+				return fmt.Sprintf("has an invalid item %d, which %s", i, problem)
+			}
+		}
+	}
+	return ""
+}
+
+// richTextNodeTypes are the node types that can't be disabled
+var richTextNodeTypes = []string{"document", "paragraph", "text", "list-item", "table-row", "table-cell", "table-header-cell"}
+
+// checkRichText describes the first node type or mark of the document that is
+// not enabled by the validations of the field.
+func checkRichText(validations *[]sdk.FieldValidation, document any) string {
+	var nodeTypes, marks []string
+	enabledNodeTypes, enabledMarks := false, false
+	if validations != nil {
+		for _, validation := range *validations {
+			if validation.EnabledNodeTypes != nil {
+				enabledNodeTypes = true
+				nodeTypes = append(nodeTypes, *validation.EnabledNodeTypes...)
+			}
+			if validation.EnabledMarks != nil {
+				enabledMarks = true
+				marks = append(marks, *validation.EnabledMarks...)
+			}
+		}
+	}
+	if !enabledNodeTypes && !enabledMarks {
+		return ""
+	}
+
+	var walk func(node any) string
+	walk = func(node any) string {
+		object, ok := node.(map[string]any)
+		if !ok {
+			return ""
+		}
+
+		nodeType, _ := object["nodeType"].(string)
+		if enabledNodeTypes && !slices.Contains(richTextNodeTypes, nodeType) && !slices.Contains(nodeTypes, nodeType) {
+			return fmt.Sprintf("uses node type %s, which is not enabled, the enabled node types are: %s", nodeType, strings.Join(nodeTypes, ", "))
+		}
+
+		if enabledMarks {
+			items, _ := object["marks"].([]any)
+			for _, item := range items {
+				markObject, _ := item.(map[string]any)
+				mark, _ := markObject["type"].(string)
+				if !slices.Contains(marks, mark) {
+					return fmt.Sprintf("uses mark %s, which is not enabled, the enabled marks are: %s", mark, strings.Join(marks, ", "))
+				}
+			}
+		}
+
+		content, _ := object["content"].([]any)
+		for _, child := range content {
+			if problem := walk(child); problem != "" {
+				return problem
+			}
+		}
+		return ""
+	}
+	return walk(document)
+}
+
+func isLink(value any) bool {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = object["sys"].(map[string]any)
+	return ok
+}
+
+// describe names the JSON type of a value for error messages
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "text"
+	case float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	case []any:
+		return "a list"
+	case map[string]any:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// fullyKnown reports whether the value and all nested values are known
+func fullyKnown(value attr.Value) bool {
+	if value == nil {
+		return true
+	}
+	if value.IsUnknown() {
+		return false
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return fullyKnown(v.UnderlyingValue())
+	case types.Object, types.Map:
+		for _, item := range elements(v) {
+			if !fullyKnown(item) {
+				return false
+			}
+		}
+	case types.List:
+		return allKnown(v.Elements())
+	case types.Set:
+		return allKnown(v.Elements())
+	case types.Tuple:
+		return allKnown(v.Elements())
+	}
+	return true
+}
+
+func allKnown(values []attr.Value) bool {
+	for _, value := range values {
+		if !fullyKnown(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(values map[string]attr.Value) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
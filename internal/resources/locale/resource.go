@@ -6,11 +6,13 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                = &localeResource{}
 	_ resource.ResourceWithConfigure   = &localeResource{}
 	_ resource.ResourceWithImportState = &localeResource{}
+	_ resource.ResourceWithModifyPlan  = &localeResource{}
 )
 
 func NewLocaleResource() resource.Resource {
@@ -30,6 +33,7 @@ func NewLocaleResource() resource.Resource {
 // localeResource is the resource implementation.
 type localeResource struct {
 	client *sdk.ClientWithResponses
+	cache  *utils.Cache
 }
 
 func (e *localeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -106,6 +110,25 @@ func (e *localeResource) Configure(_ context.Context, request resource.Configure
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.cache = data.Cache
+}
+
+func (e *localeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Record the locale, so entries that are planned after it can use it
+	// before it is created
+	var spaceID, environment, code types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("code"), &code)...)
+	if response.Diagnostics.HasError() || spaceID.IsUnknown() || environment.IsUnknown() || code.IsUnknown() {
+		return
+	}
+
+	utils.PlanLocale(e.cache, spaceID.ValueString(), environment.ValueString(), code.ValueString())
 }
 
 func (e *localeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -0,0 +1,65 @@
+package release
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// Release is the main resource schema data
+type Release struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.Int64  `tfsdk:"version"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	Environment types.String `tfsdk:"environment"`
+	Title       types.String `tfsdk:"title"`
+	Entities    []Entity     `tfsdk:"entities"`
+}
+
+// Entity is a link to an entry or asset that is part of the release
+type Entity struct {
+	ID       types.String `tfsdk:"id"`
+	LinkType types.String `tfsdk:"link_type"`
+}
+
+// Import populates the Release struct from an SDK release object
+func (r *Release) Import(release *sdk.Release) {
+	r.ID = types.StringValue(release.Sys.Id)
+	r.SpaceID = types.StringValue(release.Sys.Space.Sys.Id)
+	if release.Sys.Environment != nil {
+		r.Environment = types.StringValue(release.Sys.Environment.Sys.Id)
+	}
+	r.Version = types.Int64Value(release.Sys.Version)
+	r.Title = types.StringValue(release.Title)
+
+	r.Entities = make([]Entity, 0, len(release.Entities.Items))
+	for _, item := range release.Entities.Items {
+		r.Entities = append(r.Entities, Entity{
+			ID:       types.StringValue(item.Sys.Id),
+			LinkType: types.StringValue(item.Sys.LinkType),
+		})
+	}
+}
+
+// Draft creates a ReleaseDraft object for creating or updating a release
+func (r *Release) Draft() sdk.ReleaseDraft {
+	items := make([]sdk.SystemPropertiesReference, 0, len(r.Entities))
+	for _, entity := range r.Entities {
+		items = append(items, sdk.SystemPropertiesReference{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: entity.LinkType.ValueString(),
+				Id:       entity.ID.ValueString(),
+			},
+		})
+	}
+
+	draft := sdk.ReleaseDraft{
+		Title: r.Title.ValueString(),
+		Entities: sdk.ReleaseEntities{
+			Items: items,
+		},
+	}
+	draft.Entities.Sys.Type = sdk.ReleaseEntitiesSysTypeArray
+	return draft
+}
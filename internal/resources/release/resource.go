@@ -0,0 +1,256 @@
+package release
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &releaseResource{}
+	_ resource.ResourceWithConfigure   = &releaseResource{}
+	_ resource.ResourceWithImportState = &releaseResource{}
+)
+
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+// releaseResource is the resource implementation.
+type releaseResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *releaseResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_release"
+}
+
+func (e *releaseResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "A Contentful Release groups entries and assets so they can be published or unpublished together. " +
+			"Use the `contentful_release_action` resource to publish, unpublish or validate a release.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Release ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current version of the release",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:    true,
+				Description: "Title of the release",
+			},
+			"entities": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The entries and assets that are part of the release",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the entry or asset",
+						},
+						"link_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Type of the linked entity, either Entry or Asset",
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.GetLinkTypes()...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *releaseResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *releaseResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan Release
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	draft := plan.Draft()
+
+	resp, err := e.client.CreateReleaseWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError(
+			"Error creating release",
+			"Could not create release: "+err.Error(),
+		)
+		return
+	}
+
+	state := &Release{
+		SpaceID:     plan.SpaceID,
+		Environment: plan.Environment,
+	}
+	state.Import(resp.JSON201)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetReleaseWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading release",
+			"Could not read release: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Get plan values
+	var plan Release
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Create update parameters with version
+	params := &sdk.UpdateReleaseParams{
+		XContentfulVersion: state.Version.ValueInt64(),
+	}
+
+	draft := plan.Draft()
+	resp, err := e.client.UpdateReleaseWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		state.ID.ValueString(),
+		params,
+		draft,
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating release",
+			"Could not update release: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteReleaseWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting release",
+			"Could not delete release: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *releaseResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts, err := utils.ParseThreePartID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing release",
+			fmt.Sprintf("Expected import format: release_id:space_id:environment, got: %s", request.ID),
+		)
+		return
+	}
+
+	releaseID := idParts[0]
+	spaceID := idParts[1]
+	environment := idParts[2]
+
+	resp, err := e.client.GetReleaseWithResponse(ctx, spaceID, environment, releaseID)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing release",
+			fmt.Sprintf("Could not import release with ID %s: %s", releaseID, err.Error()),
+		)
+		return
+	}
+
+	state := &Release{
+		SpaceID:     types.StringValue(spaceID),
+		Environment: types.StringValue(environment),
+	}
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
@@ -0,0 +1,191 @@
+package release_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	hashicor_acctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+type assertFunc func(*testing.T, *sdk.Release)
+
+func TestReleaseResource_Basic(t *testing.T) {
+	assetName := fmt.Sprintf("asset-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_release.myrelease"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master-2026-02-20"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulReleaseDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testReleaseConfig(spaceID, environment, assetName, "My release"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "My release"),
+					resource.TestCheckResourceAttr(resourceName, "entities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entities.0.link_type", "Asset"),
+					resource.TestCheckResourceAttr(resourceName, "entities.0.id", assetName),
+					testAccCheckContentfulReleaseExists(t, resourceName, func(t *testing.T, release *sdk.Release) {
+						assert.Equal(t, "My release", release.Title)
+						assert.Len(t, release.Entities.Items, 1)
+					}),
+				),
+			},
+			{
+				Config: testReleaseConfig(spaceID, environment, assetName, "My updated release"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "My updated release"),
+					testAccCheckContentfulReleaseExists(t, resourceName, func(t *testing.T, release *sdk.Release) {
+						assert.Equal(t, "My updated release", release.Title)
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:%s",
+						rs.Primary.ID,
+						rs.Primary.Attributes["space_id"],
+						rs.Primary.Attributes["environment"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulReleaseExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		release, err := getReleaseFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		assertFunc(t, release)
+		return nil
+	}
+}
+
+func getReleaseFromState(s *terraform.State, resourceName string) (*sdk.Release, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("release not found in state: %s", resourceName)
+	}
+
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no release ID found")
+	}
+
+	spaceID := rs.Primary.Attributes["space_id"]
+	if spaceID == "" {
+		return nil, fmt.Errorf("no space_id is set")
+	}
+
+	environment := rs.Primary.Attributes["environment"]
+	if environment == "" {
+		return nil, fmt.Errorf("no environment is set")
+	}
+
+	client := acctest.GetClient()
+	resp, err := client.GetReleaseWithResponse(context.Background(), spaceID, environment, rs.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("release not found: %s", rs.Primary.ID)
+	}
+
+	return resp.JSON200, nil
+}
+
+func testAccCheckContentfulReleaseDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_release" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		environment := rs.Primary.Attributes["environment"]
+		if environment == "" {
+			return fmt.Errorf("no environment is set")
+		}
+
+		resp, err := client.GetReleaseWithResponse(context.Background(), spaceID, environment, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("release still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testReleaseConfig(spaceID, environment, assetName, title string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "%s"
+  environment = "%s"
+  space_id = "%s"
+  fields {
+    title = {
+      "en-US" = "Asset title"
+    }
+    description = {
+      "en-US" = "Asset description"
+    }
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
+    }
+  }
+  published = false
+  archived = false
+}
+
+resource "contentful_release" "myrelease" {
+  space_id = "%s"
+  environment = "%s"
+  title = "%s"
+  entities = [
+    {
+      id = contentful_asset.myasset.id
+      link_type = "Asset"
+    }
+  ]
+}
+`, assetName, environment, spaceID, spaceID, environment, title)
+}
@@ -0,0 +1,80 @@
+package release_action
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// ReleaseAction is the main resource schema data
+type ReleaseAction struct {
+	ID             types.String `tfsdk:"id"`
+	SpaceID        types.String `tfsdk:"space_id"`
+	Environment    types.String `tfsdk:"environment"`
+	ReleaseID      types.String `tfsdk:"release_id"`
+	ReleaseVersion types.Int64  `tfsdk:"release_version"`
+	Action         types.String `tfsdk:"action"`
+	Status         types.String `tfsdk:"status"`
+}
+
+// Import populates the ReleaseAction struct from an SDK release action object
+func (r *ReleaseAction) Import(action *sdk.ReleaseAction) {
+	r.ID = types.StringValue(action.Sys.Id)
+	r.ReleaseID = types.StringValue(action.Sys.Release.Sys.Id)
+	r.Action = types.StringValue(string(action.Action))
+	r.Status = types.StringValue(string(action.Sys.Status))
+}
+
+// IsFinished returns true when Contentful is done processing the action
+func IsFinished(action *sdk.ReleaseAction) bool {
+	return action.Sys.Status == sdk.Succeeded || action.Sys.Status == sdk.Failed
+}
+
+// ErrorDiagnostics converts the errors of a failed release action into
+// diagnostics. Each entity that failed gets its own diagnostic so the user can
+// see which entry or asset needs to be fixed.
+func ErrorDiagnostics(action *sdk.ReleaseAction) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if action.Sys.Status != sdk.Failed {
+		return diags
+	}
+
+	summary := fmt.Sprintf("Release %s failed", action.Action)
+	if action.Error == nil || action.Error.Details == nil || action.Error.Details.Errors == nil || len(*action.Error.Details.Errors) == 0 {
+		detail := fmt.Sprintf("Release action %s failed without returning any details", action.Sys.Id)
+		if action.Error != nil && action.Error.Message != nil {
+			detail = *action.Error.Message
+		}
+		diags.AddError(summary, detail)
+		return diags
+	}
+
+	for _, entityError := range *action.Error.Details.Errors {
+		diags.AddError(
+			fmt.Sprintf("%s for %s %s", summary, entityError.Entity.Sys.LinkType, entityError.Entity.Sys.Id),
+			formatEntityError(entityError.Error),
+		)
+	}
+	return diags
+}
+
+func formatEntityError(entityError map[string]interface{}) string {
+	if message, ok := entityError["message"].(string); ok && message != "" {
+		if details, ok := entityError["details"]; ok {
+			if encoded, err := json.Marshal(details); err == nil {
+				return fmt.Sprintf("%s: %s", message, encoded)
+			}
+		}
+		return message
+	}
+
+	encoded, err := json.Marshal(entityError)
+	if err != nil {
+		return fmt.Sprintf("%v", entityError)
+	}
+	return string(encoded)
+}
@@ -0,0 +1,82 @@
+package release_action
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestErrorDiagnostics_Succeeded(t *testing.T) {
+	action := &sdk.ReleaseAction{
+		Action: sdk.ReleaseActionActionPublish,
+		Sys: sdk.SystemPropertiesReleaseAction{
+			Id:     "action-1",
+			Status: sdk.Succeeded,
+		},
+	}
+
+	assert.False(t, ErrorDiagnostics(action).HasError())
+}
+
+func TestErrorDiagnostics_PerEntity(t *testing.T) {
+	errs := []sdk.ReleaseActionEntityError{
+		{
+			Entity: sdk.SystemPropertiesReference{
+				Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Entry", Id: "entry-1"},
+			},
+			Error: map[string]interface{}{
+				"message": "Validation error",
+			},
+		},
+		{
+			Entity: sdk.SystemPropertiesReference{
+				Sys: sdk.SystemPropertiesLink{Type: "Link", LinkType: "Asset", Id: "asset-1"},
+			},
+			Error: map[string]interface{}{
+				"sys": map[string]interface{}{"id": "NotFound"},
+			},
+		},
+	}
+
+	action := &sdk.ReleaseAction{
+		Action: sdk.ReleaseActionActionValidate,
+		Sys: sdk.SystemPropertiesReleaseAction{
+			Id:     "action-1",
+			Status: sdk.Failed,
+		},
+		Error: &sdk.ReleaseActionError{
+			Message: utils.Pointer("Release could not be validated"),
+		},
+	}
+	action.Error.Details = &struct {
+		Errors *[]sdk.ReleaseActionEntityError `json:"errors,omitempty"`
+	}{Errors: &errs}
+
+	diags := ErrorDiagnostics(action)
+	assert.Len(t, diags, 2)
+	assert.Equal(t, "Release validate failed for Entry entry-1", diags[0].Summary())
+	assert.Equal(t, "Validation error", diags[0].Detail())
+	assert.Equal(t, "Release validate failed for Asset asset-1", diags[1].Summary())
+	assert.Equal(t, `{"sys":{"id":"NotFound"}}`, diags[1].Detail())
+}
+
+func TestErrorDiagnostics_WithoutDetails(t *testing.T) {
+	action := &sdk.ReleaseAction{
+		Action: sdk.ReleaseActionActionPublish,
+		Sys: sdk.SystemPropertiesReleaseAction{
+			Id:     "action-1",
+			Status: sdk.Failed,
+		},
+		Error: &sdk.ReleaseActionError{
+			Message: utils.Pointer("Something went wrong"),
+		},
+	}
+
+	diags := ErrorDiagnostics(action)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Release publish failed", diags[0].Summary())
+	assert.Equal(t, "Something went wrong", diags[0].Detail())
+}
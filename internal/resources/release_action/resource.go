@@ -0,0 +1,268 @@
+package release_action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &releaseActionResource{}
+	_ resource.ResourceWithConfigure = &releaseActionResource{}
+)
+
+var errActionInProgress = errors.New("release action is still in progress")
+
+func NewReleaseActionResource() resource.Resource {
+	return &releaseActionResource{}
+}
+
+// releaseActionResource is the resource implementation.
+type releaseActionResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *releaseActionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_release_action"
+}
+
+func (e *releaseActionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Runs an action (publish, unpublish or validate) on a Contentful Release and waits for it to " +
+			"finish. Changing any of the attributes will run a new action. Removing this resource does not revert " +
+			"the action.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Release action ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the release to run the action on",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release_version": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Description: "Version of the release to run the action on. Defaults to the current version of the " +
+					"release. Set this to the `version` of the `contentful_release` resource to run the action again " +
+					"when the release changes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The action to run on the release, one of publish, unpublish or validate",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sdk.ReleaseActionActionPublish),
+						string(sdk.ReleaseActionActionUnpublish),
+						string(sdk.ReleaseActionActionValidate),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the release action",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (e *releaseActionResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *releaseActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan ReleaseAction
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	environment := plan.Environment.ValueString()
+	releaseID := plan.ReleaseID.ValueString()
+
+	version := plan.ReleaseVersion.ValueInt64()
+	if plan.ReleaseVersion.IsNull() || plan.ReleaseVersion.IsUnknown() {
+		resp, err := e.client.GetReleaseWithResponse(ctx, spaceID, environment, releaseID)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error reading release",
+				fmt.Sprintf("Could not read release %s: %s", releaseID, err.Error()),
+			)
+			return
+		}
+		version = resp.JSON200.Sys.Version
+	}
+
+	action, err := e.startAction(ctx, plan.Action.ValueString(), spaceID, environment, releaseID, version)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Error running release action %s", plan.Action.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	action, err = e.waitForAction(ctx, spaceID, environment, releaseID, action.Sys.Id)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Error running release action %s", plan.Action.ValueString()),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(ErrorDiagnostics(action)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Import(action)
+	plan.ReleaseVersion = types.Int64Value(version)
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *releaseActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state ReleaseAction
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetReleaseActionWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ReleaseID.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading release action",
+			"Could not read release action: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// All configurable attributes require a replacement, so there is nothing
+	// to send to Contentful here.
+	var plan ReleaseAction
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *releaseActionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Release actions can't be deleted or reverted, removing the resource only
+	// removes it from the state.
+}
+
+func (e *releaseActionResource) startAction(ctx context.Context, action, spaceID, environment, releaseID string, version int64) (*sdk.ReleaseAction, error) {
+	switch sdk.ReleaseActionAction(action) {
+	case sdk.ReleaseActionActionPublish:
+		resp, err := e.client.PublishReleaseWithResponse(ctx, spaceID, environment, releaseID, &sdk.PublishReleaseParams{
+			XContentfulVersion: version,
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusAccepted); err != nil {
+			return nil, err
+		}
+		return resp.JSON202, nil
+	case sdk.ReleaseActionActionUnpublish:
+		resp, err := e.client.UnpublishReleaseWithResponse(ctx, spaceID, environment, releaseID, &sdk.UnpublishReleaseParams{
+			XContentfulVersion: version,
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusAccepted); err != nil {
+			return nil, err
+		}
+		return resp.JSON202, nil
+	case sdk.ReleaseActionActionValidate:
+		resp, err := e.client.ValidateReleaseWithResponse(ctx, spaceID, environment, releaseID, sdk.ReleaseValidate{
+			Action: utils.Pointer(sdk.ReleaseValidateActionPublish),
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusAccepted); err != nil {
+			return nil, err
+		}
+		return resp.JSON202, nil
+	}
+
+	return nil, fmt.Errorf("unsupported release action: %s", action)
+}
+
+// waitForAction polls the release action until Contentful reports that it
+// either succeeded or failed.
+func (e *releaseActionResource) waitForAction(ctx context.Context, spaceID, environment, releaseID, actionID string) (*sdk.ReleaseAction, error) {
+	return backoff.Retry(ctx, func() (*sdk.ReleaseAction, error) {
+		resp, err := e.client.GetReleaseActionWithResponse(ctx, spaceID, environment, releaseID, actionID)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, backoff.Permanent(err)
+		}
+
+		if !IsFinished(resp.JSON200) {
+			return nil, errActionInProgress
+		}
+		return resp.JSON200, nil
+	}, backoff.WithMaxElapsedTime(10*time.Minute), backoff.WithBackOff(backoff.NewExponentialBackOff()))
+}
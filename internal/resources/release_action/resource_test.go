@@ -0,0 +1,86 @@
+package release_action_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	hashicor_acctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestReleaseActionResource_Validate(t *testing.T) {
+	assetName := fmt.Sprintf("asset-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_release_action.validate"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master-2026-02-20"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testReleaseActionConfig(spaceID, environment, assetName, "validate"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "validate"),
+					resource.TestCheckResourceAttr(resourceName, "status", "succeeded"),
+					resource.TestCheckResourceAttrPair(resourceName, "release_version", "contentful_release.myrelease", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testReleaseActionConfig(spaceID, environment, assetName, action string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "%s"
+  environment = "%s"
+  space_id = "%s"
+  fields {
+    title = {
+      "en-US" = "Asset title"
+    }
+    description = {
+      "en-US" = "Asset description"
+    }
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
+    }
+  }
+  published = false
+  archived = false
+}
+
+resource "contentful_release" "myrelease" {
+  space_id = "%s"
+  environment = "%s"
+  title = "Release action test"
+  entities = [
+    {
+      id = contentful_asset.myasset.id
+      link_type = "Asset"
+    }
+  ]
+}
+
+resource "contentful_release_action" "validate" {
+  space_id = "%s"
+  environment = "%s"
+  release_id = contentful_release.myrelease.id
+  release_version = contentful_release.myrelease.version
+  action = "%s"
+}
+`, assetName, environment, spaceID, spaceID, environment, spaceID, environment, action)
+}
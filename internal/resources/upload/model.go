@@ -0,0 +1,27 @@
+package upload
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// Upload is the main resource schema data
+type Upload struct {
+	ID          types.String `tfsdk:"id"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	Environment types.String `tfsdk:"environment"`
+	Source      types.String `tfsdk:"source"`
+	SourceHash  types.String `tfsdk:"source_hash"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (u *Upload) Import(n *sdk.Upload) {
+	u.ID = types.StringValue(n.Sys.Id)
+	u.ExpiresAt = types.StringNull()
+	if n.Sys.ExpiresAt != nil {
+		u.ExpiresAt = types.StringValue(n.Sys.ExpiresAt.Format(time.RFC3339))
+	}
+}
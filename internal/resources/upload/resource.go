@@ -0,0 +1,219 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &uploadResource{}
+	_ resource.ResourceWithConfigure  = &uploadResource{}
+	_ resource.ResourceWithModifyPlan = &uploadResource{}
+)
+
+func NewUploadResource() resource.Resource {
+	return &uploadResource{}
+}
+
+// uploadResource is the resource implementation.
+type uploadResource struct {
+	clientUpload *sdk.ClientWithResponses
+}
+
+func (e *uploadResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_upload"
+}
+
+func (e *uploadResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "An upload holds a local file in Contentful so it can be linked into an asset with " +
+			"`upload_from`. A change of the file contents creates a new upload. Contentful removes uploads 48 " +
+			"hours after they are created, an expired upload is kept in the state as assets that were processed " +
+			"from it no longer need it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "upload id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "space id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "environment id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to the local file to upload.",
+			},
+			"source_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "The sha256 hash of the uploaded file, a change creates a new upload.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which Contentful removes the upload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (e *uploadResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.clientUpload = data.ClientUpload
+}
+
+func (e *uploadResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Upload
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() {
+		plan.SourceHash = types.StringUnknown()
+	} else {
+		hash, err := utils.HashFile(plan.Source.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("source"),
+				"Error reading upload source",
+				fmt.Sprintf("Could not read %s: %s", plan.Source.ValueString(), err.Error()),
+			)
+			return
+		}
+		plan.SourceHash = types.StringValue(hash)
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state Upload
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.SourceHash.Equal(state.SourceHash) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root("source_hash"))
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, plan)...)
+}
+
+func (e *uploadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan Upload
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	upload, hash, err := utils.UploadFile(ctx, e.clientUpload, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.Source.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error creating upload", "Could not upload "+plan.Source.ValueString()+": "+err.Error())
+		return
+	}
+
+	plan.Import(upload)
+	plan.SourceHash = types.StringValue(hash)
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *uploadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state Upload
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.clientUpload.GetUploadWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			tflog.Warn(ctx, fmt.Sprintf("Upload %s has expired", state.ID.ValueString()))
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading upload",
+			fmt.Sprintf("Could not retrieve upload, unexpected error: %s", err.Error()),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *uploadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// A changed source path with the same contents doesn't need a new upload,
+	// all other changes require a replacement.
+	var plan Upload
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state Upload
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.ExpiresAt = state.ExpiresAt
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *uploadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state Upload
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.clientUpload.DeleteUploadWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error deleting upload",
+			"Could not delete upload, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
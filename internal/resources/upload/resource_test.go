@@ -0,0 +1,77 @@
+package upload_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestUploadResource_Create(t *testing.T) {
+	resourceName := "contentful_upload.myupload"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulUploadDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUploadConfig(spaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestMatchResourceAttr(resourceName, "source_hash", regexp.MustCompile(`^[a-f0-9]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulUploadDestroy(s *terraform.State) error {
+	client, err := utils.CreateClient("https://upload.contentful.com", os.Getenv("CONTENTFUL_MANAGEMENT_TOKEN"))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_upload" {
+			continue
+		}
+
+		resp, err := client.GetUploadWithResponse(context.Background(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("upload still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testUploadConfig(spaceID string) string {
+	return fmt.Sprintf(`
+resource "contentful_upload" "myupload" {
+  space_id    = "%s"
+  environment = "master-2026-02-20"
+  source      = "test_resources/terms.txt"
+}
+`, spaceID)
+}
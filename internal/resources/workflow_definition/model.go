@@ -0,0 +1,347 @@
+package workflow_definition
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const (
+	colorAnnotationPrefix = "cf-color-"
+	allActors             = "all"
+)
+
+// WorkflowDefinition is the main resource schema data
+type WorkflowDefinition struct {
+	ID           types.String   `tfsdk:"id"`
+	Version      types.Int64    `tfsdk:"version"`
+	SpaceID      types.String   `tfsdk:"space_id"`
+	Environment  types.String   `tfsdk:"environment"`
+	Name         types.String   `tfsdk:"name"`
+	Description  types.String   `tfsdk:"description"`
+	ContentTypes []types.String `tfsdk:"content_types"`
+	Steps        []Step         `tfsdk:"steps"`
+}
+
+// Step is a single review step of the workflow
+type Step struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	Actions     []Action     `tfsdk:"actions"`
+	Permissions []Permission `tfsdk:"permissions"`
+}
+
+// Action is executed when an entry moves into the step
+type Action struct {
+	Type          types.String         `tfsdk:"type"`
+	AppID         types.String         `tfsdk:"app_id"`
+	AppActionID   types.String         `tfsdk:"app_action_id"`
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+}
+
+// Permission restricts what can be done with an entry while it is in the step
+type Permission struct {
+	Action types.String   `tfsdk:"action"`
+	Effect types.String   `tfsdk:"effect"`
+	Roles  []types.String `tfsdk:"roles"`
+	Users  []types.String `tfsdk:"users"`
+}
+
+// Import populates the WorkflowDefinition struct from an SDK workflow definition object
+func (w *WorkflowDefinition) Import(definition *sdk.WorkflowDefinition) {
+	w.ID = types.StringValue(definition.Sys.Id)
+	w.Version = types.Int64Value(definition.Sys.Version)
+	w.SpaceID = types.StringValue(definition.Sys.Space.Sys.Id)
+	if definition.Sys.Environment != nil {
+		w.Environment = types.StringValue(definition.Sys.Environment.Sys.Id)
+	}
+	w.Name = types.StringValue(definition.Name)
+	w.Description = types.StringPointerValue(definition.Description)
+
+	w.ContentTypes = nil
+	if definition.AppliesTo != nil {
+		for _, appliesTo := range *definition.AppliesTo {
+			for _, validation := range appliesTo.Validations {
+				if validation.LinkContentType == nil {
+					continue
+				}
+				for _, contentType := range *validation.LinkContentType {
+					w.ContentTypes = append(w.ContentTypes, types.StringValue(contentType))
+				}
+			}
+		}
+	}
+
+	w.Steps = make([]Step, 0, len(definition.Steps))
+	for _, step := range definition.Steps {
+		w.Steps = append(w.Steps, importStep(step))
+	}
+}
+
+func importStep(step sdk.WorkflowStep) Step {
+	result := Step{
+		ID:          types.StringPointerValue(step.Id),
+		Name:        types.StringValue(step.Name),
+		Description: types.StringPointerValue(step.Description),
+		Color:       types.StringNull(),
+	}
+
+	if step.Annotations != nil {
+		for _, annotation := range *step.Annotations {
+			if color, ok := strings.CutPrefix(annotation, colorAnnotationPrefix); ok {
+				result.Color = types.StringValue(color)
+			}
+		}
+	}
+
+	if step.Actions != nil && len(*step.Actions) > 0 {
+		for _, action := range *step.Actions {
+			item := Action{
+				Type:          types.StringValue(string(action.Type)),
+				AppID:         types.StringPointerValue(action.AppId),
+				AppActionID:   types.StringPointerValue(action.AppActionId),
+				Configuration: jsontypes.NewNormalizedNull(),
+			}
+			if action.Configuration != nil {
+				data, _ := json.Marshal(action.Configuration)
+				item.Configuration = jsontypes.NewNormalizedValue(string(data))
+			}
+			result.Actions = append(result.Actions, item)
+		}
+	}
+
+	if step.Permissions != nil && len(*step.Permissions) > 0 {
+		for _, permission := range *step.Permissions {
+			result.Permissions = append(result.Permissions, importPermission(permission))
+		}
+	}
+
+	return result
+}
+
+func importPermission(permission sdk.WorkflowStepPermission) Permission {
+	result := Permission{
+		Action: types.StringValue(string(permission.Configuration.Action)),
+		Effect: types.StringValue(string(permission.Configuration.Effect)),
+	}
+
+	// Actors is either the string "all" or a list of links
+	actors, ok := permission.Configuration.Actors.([]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, actor := range actors {
+		actorMap, ok := actor.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sys, ok := actorMap["sys"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := sys["id"].(string)
+		switch sys["linkType"] {
+		case "Role":
+			result.Roles = append(result.Roles, types.StringValue(id))
+		case "User":
+			result.Users = append(result.Users, types.StringValue(id))
+		}
+	}
+
+	return result
+}
+
+// MatchStepIDs sets the IDs of the steps that are not known yet to the ID of
+// the step in the state with the same name. Steps that are new, or renamed, get
+// a new ID from Contentful.
+func (w *WorkflowDefinition) MatchStepIDs(state *WorkflowDefinition) {
+	ids := map[string][]types.String{}
+	for _, step := range state.Steps {
+		ids[step.Name.ValueString()] = append(ids[step.Name.ValueString()], step.ID)
+	}
+
+	for i, step := range w.Steps {
+		if !step.ID.IsUnknown() || step.Name.IsUnknown() {
+			continue
+		}
+
+		matches := ids[step.Name.ValueString()]
+		if len(matches) == 0 {
+			continue
+		}
+		w.Steps[i].ID = matches[0]
+		ids[step.Name.ValueString()] = matches[1:]
+	}
+}
+
+// stepRefs returns the steps of the list with only their ID and name, so a plan
+// with unknown values in the other attributes of the steps can be matched.
+func stepRefs(steps types.List) []Step {
+	if steps.IsNull() || steps.IsUnknown() {
+		return nil
+	}
+
+	var result []Step
+	for _, element := range steps.Elements() {
+		step := Step{ID: types.StringUnknown(), Name: types.StringUnknown()}
+		if object, ok := element.(types.Object); ok && !object.IsUnknown() {
+			if value, ok := object.Attributes()["id"].(types.String); ok {
+				step.ID = value
+			}
+			if value, ok := object.Attributes()["name"].(types.String); ok {
+				step.Name = value
+			}
+		}
+		result = append(result, step)
+	}
+	return result
+}
+
+// Draft creates a WorkflowDefinitionDraft object for creating or updating a
+// workflow definition. The annotations of the steps in current, the definition
+// in Contentful, are kept so annotations that are added in the web app are not
+// removed.
+func (w *WorkflowDefinition) Draft(current *sdk.WorkflowDefinition) (sdk.WorkflowDefinitionDraft, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	contentTypes := make([]string, 0, len(w.ContentTypes))
+	for _, contentType := range w.ContentTypes {
+		contentTypes = append(contentTypes, contentType.ValueString())
+	}
+
+	draft := sdk.WorkflowDefinitionDraft{
+		Name: w.Name.ValueString(),
+		AppliesTo: &[]sdk.WorkflowDefinitionAppliesTo{
+			{
+				Type:     sdk.WorkflowDefinitionAppliesToTypeLink,
+				LinkType: sdk.WorkflowDefinitionAppliesToLinkTypeEntry,
+				Validations: []sdk.WorkflowDefinitionAppliesToValidation{
+					{LinkContentType: &contentTypes},
+				},
+			},
+		},
+		Steps: make([]sdk.WorkflowStep, 0, len(w.Steps)),
+	}
+
+	if !w.Description.IsNull() && !w.Description.IsUnknown() {
+		draft.Description = w.Description.ValueStringPointer()
+	}
+
+	currentSteps := map[string]*sdk.WorkflowStep{}
+	if current != nil {
+		for i, step := range current.Steps {
+			if step.Id != nil {
+				currentSteps[*step.Id] = &current.Steps[i]
+			}
+		}
+	}
+
+	for _, step := range w.Steps {
+		item, stepDiags := step.Draft(currentSteps[step.ID.ValueString()])
+		diags.Append(stepDiags...)
+		draft.Steps = append(draft.Steps, item)
+	}
+
+	return draft, diags
+}
+
+// Draft creates the SDK representation of a workflow step. Only the color
+// annotation of current, the step in Contentful, is replaced.
+func (s *Step) Draft(current *sdk.WorkflowStep) (sdk.WorkflowStep, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	step := sdk.WorkflowStep{
+		Name:        s.Name.ValueString(),
+		Actions:     &[]sdk.WorkflowStepAction{},
+		Permissions: &[]sdk.WorkflowStepPermission{},
+	}
+
+	if !s.ID.IsNull() && !s.ID.IsUnknown() {
+		step.Id = s.ID.ValueStringPointer()
+	}
+
+	if !s.Description.IsNull() && !s.Description.IsUnknown() {
+		step.Description = s.Description.ValueStringPointer()
+	}
+
+	var annotations []string
+	if current != nil && current.Annotations != nil {
+		for _, annotation := range *current.Annotations {
+			if !strings.HasPrefix(annotation, colorAnnotationPrefix) {
+				annotations = append(annotations, annotation)
+			}
+		}
+	}
+	if !s.Color.IsNull() && !s.Color.IsUnknown() {
+		annotations = append(annotations, colorAnnotationPrefix+s.Color.ValueString())
+	}
+	if len(annotations) > 0 {
+		step.Annotations = &annotations
+	}
+
+	for _, action := range s.Actions {
+		item := sdk.WorkflowStepAction{
+			Type: sdk.WorkflowStepActionType(action.Type.ValueString()),
+		}
+		if !action.AppID.IsNull() && !action.AppID.IsUnknown() {
+			item.AppId = action.AppID.ValueStringPointer()
+		}
+		if !action.AppActionID.IsNull() && !action.AppActionID.IsUnknown() {
+			item.AppActionId = action.AppActionID.ValueStringPointer()
+		}
+		if !action.Configuration.IsNull() && !action.Configuration.IsUnknown() {
+			configuration := make(map[string]interface{})
+			diags.Append(action.Configuration.Unmarshal(&configuration)...)
+			item.Configuration = &configuration
+		}
+		*step.Actions = append(*step.Actions, item)
+	}
+
+	for _, permission := range s.Permissions {
+		*step.Permissions = append(*step.Permissions, permission.Draft())
+	}
+
+	return step, diags
+}
+
+// Draft creates the SDK representation of a step permission. When no roles
+// or users are given the permission applies to everyone.
+func (p *Permission) Draft() sdk.WorkflowStepPermission {
+	var actors interface{} = allActors
+	if len(p.Roles) > 0 || len(p.Users) > 0 {
+		links := make([]sdk.SystemPropertiesReference, 0, len(p.Roles)+len(p.Users))
+		for _, role := range p.Roles {
+			links = append(links, actorLink("Role", role.ValueString()))
+		}
+		for _, user := range p.Users {
+			links = append(links, actorLink("User", user.ValueString()))
+		}
+		actors = links
+	}
+
+	return sdk.WorkflowStepPermission{
+		Type: sdk.EntityPermission,
+		Configuration: sdk.WorkflowStepPermissionConfiguration{
+			Action: sdk.WorkflowStepPermissionConfigurationAction(p.Action.ValueString()),
+			Effect: sdk.WorkflowStepPermissionConfigurationEffect(p.Effect.ValueString()),
+			Actors: actors,
+		},
+	}
+}
+
+func actorLink(linkType, id string) sdk.SystemPropertiesReference {
+	return sdk.SystemPropertiesReference{
+		Sys: sdk.SystemPropertiesLink{
+			Type:     "Link",
+			LinkType: linkType,
+			Id:       id,
+		},
+	}
+}
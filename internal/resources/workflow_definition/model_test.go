@@ -0,0 +1,158 @@
+package workflow_definition
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func TestPermissionDraft_AllActors(t *testing.T) {
+	permission := Permission{
+		Action: types.StringValue("publish"),
+		Effect: types.StringValue("deny"),
+	}
+
+	draft := permission.Draft()
+	assert.Equal(t, sdk.EntityPermission, draft.Type)
+	assert.Equal(t, sdk.Publish, draft.Configuration.Action)
+	assert.Equal(t, sdk.Deny, draft.Configuration.Effect)
+	assert.Equal(t, "all", draft.Configuration.Actors)
+}
+
+func TestWorkflowDefinition_RoundTrip(t *testing.T) {
+	definition := WorkflowDefinition{
+		Name:         types.StringValue("Editorial review"),
+		Description:  types.StringNull(),
+		ContentTypes: []types.String{types.StringValue("article")},
+		Steps: []Step{
+			{
+				ID:          types.StringUnknown(),
+				Name:        types.StringValue("In review"),
+				Description: types.StringValue("Waiting for a reviewer"),
+				Color:       types.StringValue("blue"),
+				Actions: []Action{
+					{
+						Type:          types.StringValue("email"),
+						AppID:         types.StringNull(),
+						AppActionID:   types.StringNull(),
+						Configuration: jsontypes.NewNormalizedValue(`{"recipients":[{"sys":{"id":"user-1","linkType":"User","type":"Link"}}]}`),
+					},
+				},
+				Permissions: []Permission{
+					{
+						Action: types.StringValue("publish"),
+						Effect: types.StringValue("allow"),
+						Roles:  []types.String{types.StringValue("role-1")},
+						Users:  []types.String{types.StringValue("user-1")},
+					},
+				},
+			},
+		},
+	}
+
+	draft, diags := definition.Draft(nil)
+	require.False(t, diags.HasError())
+	require.Len(t, *draft.AppliesTo, 1)
+	assert.Equal(t, []string{"article"}, *(*draft.AppliesTo)[0].Validations[0].LinkContentType)
+	require.Len(t, draft.Steps, 1)
+	assert.Nil(t, draft.Steps[0].Id)
+	assert.Equal(t, []string{"cf-color-blue"}, *draft.Steps[0].Annotations)
+
+	// Send the draft through JSON to get the same shapes the API returns
+	data, err := json.Marshal(draft)
+	require.NoError(t, err)
+
+	response := &sdk.WorkflowDefinition{}
+	require.NoError(t, json.Unmarshal(data, response))
+	response.Sys.Id = "workflow-1"
+	response.Sys.Version = 3
+	response.Sys.Space.Sys.Id = "space-1"
+	response.Steps[0].Id = &[]string{"step-1"}[0]
+
+	result := WorkflowDefinition{}
+	result.Import(response)
+
+	assert.Equal(t, "workflow-1", result.ID.ValueString())
+	assert.Equal(t, int64(3), result.Version.ValueInt64())
+	assert.True(t, result.Description.IsNull())
+	assert.Equal(t, definition.ContentTypes, result.ContentTypes)
+	require.Len(t, result.Steps, 1)
+
+	step := result.Steps[0]
+	assert.Equal(t, "step-1", step.ID.ValueString())
+	assert.Equal(t, "In review", step.Name.ValueString())
+	assert.Equal(t, "blue", step.Color.ValueString())
+	require.Len(t, step.Actions, 1)
+	assert.Equal(t, "email", step.Actions[0].Type.ValueString())
+	assert.JSONEq(t, definition.Steps[0].Actions[0].Configuration.ValueString(), step.Actions[0].Configuration.ValueString())
+	assert.Equal(t, definition.Steps[0].Permissions, step.Permissions)
+}
+
+func TestWorkflowDefinition_MatchStepIDs(t *testing.T) {
+	state := WorkflowDefinition{
+		Steps: []Step{
+			{ID: types.StringValue("step-1"), Name: types.StringValue("In review")},
+			{ID: types.StringValue("step-2"), Name: types.StringValue("Approved")},
+		},
+	}
+
+	// A step is inserted at the front, the other steps keep their ID
+	plan := WorkflowDefinition{
+		Steps: []Step{
+			{ID: types.StringUnknown(), Name: types.StringValue("Draft")},
+			{ID: types.StringUnknown(), Name: types.StringValue("In review")},
+			{ID: types.StringUnknown(), Name: types.StringValue("Approved")},
+		},
+	}
+	plan.MatchStepIDs(&state)
+
+	assert.True(t, plan.Steps[0].ID.IsUnknown())
+	assert.Equal(t, "step-1", plan.Steps[1].ID.ValueString())
+	assert.Equal(t, "step-2", plan.Steps[2].ID.ValueString())
+
+	draft, diags := plan.Draft(nil)
+	require.False(t, diags.HasError())
+	assert.Nil(t, draft.Steps[0].Id)
+	assert.Equal(t, "step-1", *draft.Steps[1].Id)
+	assert.Equal(t, "step-2", *draft.Steps[2].Id)
+}
+
+func TestStepDraft_KeepsAnnotations(t *testing.T) {
+	step := Step{
+		ID:    types.StringValue("step-1"),
+		Name:  types.StringValue("In review"),
+		Color: types.StringValue("green"),
+	}
+	current := &sdk.WorkflowStep{
+		Id:          &[]string{"step-1"}[0],
+		Annotations: &[]string{"cf-color-blue", "cf-icon-review"},
+	}
+
+	draft, _ := step.Draft(current)
+	assert.Equal(t, []string{"cf-icon-review", "cf-color-green"}, *draft.Annotations)
+
+	step.Color = types.StringNull()
+	draft, _ = step.Draft(current)
+	assert.Equal(t, []string{"cf-icon-review"}, *draft.Annotations)
+}
+
+func TestStepDraft_InvalidConfiguration(t *testing.T) {
+	step := Step{
+		Name: types.StringValue("In review"),
+		Actions: []Action{
+			{
+				Type:          types.StringValue("email"),
+				Configuration: jsontypes.NewNormalizedValue(`["not", "an", "object"]`),
+			},
+		},
+	}
+
+	_, diags := step.Draft(nil)
+	assert.True(t, diags.HasError())
+}
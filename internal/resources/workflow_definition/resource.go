@@ -0,0 +1,459 @@
+package workflow_definition
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowDefinitionResource{}
+	_ resource.ResourceWithConfigure   = &workflowDefinitionResource{}
+	_ resource.ResourceWithImportState = &workflowDefinitionResource{}
+	_ resource.ResourceWithModifyPlan  = &workflowDefinitionResource{}
+)
+
+func NewWorkflowDefinitionResource() resource.Resource {
+	return &workflowDefinitionResource{}
+}
+
+// workflowDefinitionResource is the resource implementation.
+type workflowDefinitionResource struct {
+	client *sdk.ClientWithResponses
+	cache  *utils.Cache
+}
+
+func (e *workflowDefinitionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_workflow_definition"
+}
+
+func (e *workflowDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "A Contentful Workflow Definition describes the review steps entries of the given content types " +
+			"go through. This requires the Workflows feature to be enabled for the space.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workflow definition ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current version of the workflow definition",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the workflow definition",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the workflow definition",
+			},
+			"content_types": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the content types the workflow applies to. The content types need to exist in the same environment.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"steps": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The ordered list of steps an entry goes through",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the step. Steps keep their ID when they are moved, as they are matched by name",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the step",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Description of the step",
+						},
+						"color": schema.StringAttribute{
+							Optional:    true,
+							Description: "Color of the step in the web app, for example blue, green, yellow, orange, red or purple",
+						},
+						"actions": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Actions that are executed when an entry moves into this step",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "Type of the action, one of email, task or app",
+										Validators: []validator.String{
+											stringvalidator.OneOf(
+												string(sdk.WorkflowStepActionTypeEmail),
+												string(sdk.WorkflowStepActionTypeTask),
+												string(sdk.WorkflowStepActionTypeApp),
+											),
+										},
+									},
+									"app_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "ID of the app definition that provides the app action. Only used for app actions",
+									},
+									"app_action_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "ID of the app action to call. Only used for app actions",
+									},
+									"configuration": schema.StringAttribute{
+										CustomType:  jsontypes.NormalizedType{},
+										Optional:    true,
+										Description: "Configuration of the action as a JSON string, for example the recipients of an email action",
+									},
+								},
+							},
+						},
+						"permissions": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Permissions that apply to entries while they are in this step",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"action": schema.StringAttribute{
+										Required:    true,
+										Description: "The entry action this permission applies to, one of edit, publish or delete",
+										Validators: []validator.String{
+											stringvalidator.OneOf(
+												string(sdk.Edit),
+												string(sdk.Publish),
+												string(sdk.Delete),
+											),
+										},
+									},
+									"effect": schema.StringAttribute{
+										Required:    true,
+										Description: "Whether the action is allowed or denied, one of allow or deny",
+										Validators: []validator.String{
+											stringvalidator.OneOf(string(sdk.Allow), string(sdk.Deny)),
+										},
+									},
+									"roles": schema.ListAttribute{
+										Optional:    true,
+										ElementType: types.StringType,
+										Description: "IDs of the roles the permission applies to",
+									},
+									"users": schema.ListAttribute{
+										Optional:    true,
+										ElementType: types.StringType,
+										Description: "IDs of the users the permission applies to. When neither roles nor users are set the permission applies to everyone",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *workflowDefinitionResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.cache = data.Cache
+}
+
+func (e *workflowDefinitionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	response.Diagnostics.Append(e.checkContentTypes(ctx, request)...)
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var planSteps, stateSteps types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("steps"), &planSteps)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("steps"), &stateSteps)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID of a step is taken from the step with the same name and not from
+	// the step at the same position, so inserting a step keeps the entries in
+	// the steps they are in.
+	plan := WorkflowDefinition{Steps: stepRefs(planSteps)}
+	plan.MatchStepIDs(&WorkflowDefinition{Steps: stepRefs(stateSteps)})
+	for i, step := range plan.Steps {
+		if step.ID.IsUnknown() {
+			continue
+		}
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("steps").AtListIndex(i).AtName("id"), step.ID)...)
+	}
+}
+
+func (e *workflowDefinitionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan WorkflowDefinition
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	draft, diags := plan.Draft(nil)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.CreateWorkflowDefinitionWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError(
+			"Error creating workflow definition",
+			"Could not create workflow definition: "+err.Error(),
+		)
+		return
+	}
+
+	state := &WorkflowDefinition{
+		SpaceID:     plan.SpaceID,
+		Environment: plan.Environment,
+	}
+	state.Import(resp.JSON201)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *workflowDefinitionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state WorkflowDefinition
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetWorkflowDefinitionWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(
+			"Error reading workflow definition",
+			"Could not read workflow definition: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *workflowDefinitionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Get plan values
+	var plan WorkflowDefinition
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state WorkflowDefinition
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Create update parameters with version
+	params := &sdk.UpdateWorkflowDefinitionParams{
+		XContentfulVersion: state.Version.ValueInt64(),
+	}
+
+	// Read the current definition, so the annotations of the steps that are
+	// not managed are kept
+	current, err := e.client.GetWorkflowDefinitionWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+	)
+	if err := utils.CheckClientResponse(current, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating workflow definition",
+			"Could not read workflow definition: "+err.Error(),
+		)
+		return
+	}
+
+	draft, diags := plan.Draft(current.JSON200)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	resp, err := e.client.UpdateWorkflowDefinitionWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		state.ID.ValueString(),
+		params,
+		draft,
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating workflow definition",
+			"Could not update workflow definition: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *workflowDefinitionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state WorkflowDefinition
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	params := &sdk.DeleteWorkflowDefinitionParams{
+		XContentfulVersion: state.Version.ValueInt64(),
+	}
+
+	resp, err := e.client.DeleteWorkflowDefinitionWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		params,
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		response.Diagnostics.AddError(
+			"Error deleting workflow definition",
+			"Could not delete workflow definition: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (e *workflowDefinitionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts, err := utils.ParseThreePartID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing workflow definition",
+			fmt.Sprintf("Expected import format: workflow_definition_id:space_id:environment, got: %s", request.ID),
+		)
+		return
+	}
+
+	definitionID := idParts[0]
+	spaceID := idParts[1]
+	environment := idParts[2]
+
+	resp, err := e.client.GetWorkflowDefinitionWithResponse(ctx, spaceID, environment, definitionID)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing workflow definition",
+			fmt.Sprintf("Could not import workflow definition with ID %s: %s", definitionID, err.Error()),
+		)
+		return
+	}
+
+	state := &WorkflowDefinition{
+		SpaceID:     types.StringValue(spaceID),
+		Environment: types.StringValue(environment),
+	}
+	state.Import(resp.JSON200)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// checkContentTypes verifies that all content types the workflow applies to
+// exist in the environment of the workflow definition. Content types that are
+// planned before the workflow definition are created in the same apply, and
+// values that are not known yet are skipped.
+func (e *workflowDefinitionResource) checkContentTypes(ctx context.Context, request resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var spaceID, environment types.String
+	var contentTypes types.List
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root("content_types"), &contentTypes)...)
+	if diags.HasError() || spaceID.IsUnknown() || environment.IsUnknown() || contentTypes.IsUnknown() {
+		return diags
+	}
+
+	for i, element := range contentTypes.Elements() {
+		contentTypeID, ok := element.(types.String)
+		if !ok || contentTypeID.IsUnknown() || contentTypeID.IsNull() {
+			continue
+		}
+
+		id := contentTypeID.ValueString()
+		if e.cache.Has(utils.PlannedContentTypeKey(spaceID.ValueString(), environment.ValueString(), id)) {
+			continue
+		}
+
+		contentType, err := utils.CachedContentType(ctx, e.client, e.cache, spaceID.ValueString(), environment.ValueString(), id)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("content_types").AtListIndex(i),
+				"Error reading content type",
+				fmt.Sprintf("Could not read content type %s: %s", id, err.Error()),
+			)
+			continue
+		}
+		if contentType == nil {
+			diags.AddAttributeError(
+				path.Root("content_types").AtListIndex(i),
+				"Content type not found",
+				fmt.Sprintf("Content type %s does not exist in environment %s and is not managed in this configuration. "+
+					"Refer to a content type that is created in the same apply with its id attribute, so it is planned first.",
+					id, environment.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,202 @@
+package workflow_definition_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	hashicor_acctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+type assertFunc func(*testing.T, *sdk.WorkflowDefinition)
+
+func TestWorkflowDefinitionResource_Basic(t *testing.T) {
+	name := fmt.Sprintf("workflow-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_workflow_definition.myworkflow"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master-2026-02-20"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulWorkflowDefinitionDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testWorkflowDefinitionConfig(spaceID, environment, name, "In review"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "content_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.name", "In review"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.color", "blue"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.permissions.0.action", "publish"),
+					resource.TestCheckResourceAttrSet(resourceName, "steps.0.id"),
+					testAccCheckContentfulWorkflowDefinitionExists(t, resourceName, func(t *testing.T, definition *sdk.WorkflowDefinition) {
+						assert.Equal(t, name, definition.Name)
+						assert.Len(t, definition.Steps, 2)
+					}),
+				),
+			},
+			{
+				Config: testWorkflowDefinitionConfig(spaceID, environment, name, "Ready for review"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "steps.0.name", "Ready for review"),
+					testAccCheckContentfulWorkflowDefinitionExists(t, resourceName, func(t *testing.T, definition *sdk.WorkflowDefinition) {
+						assert.Equal(t, "Ready for review", definition.Steps[0].Name)
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:%s",
+						rs.Primary.ID,
+						rs.Primary.Attributes["space_id"],
+						rs.Primary.Attributes["environment"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulWorkflowDefinitionExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		definition, err := getWorkflowDefinitionFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		assertFunc(t, definition)
+		return nil
+	}
+}
+
+func getWorkflowDefinitionFromState(s *terraform.State, resourceName string) (*sdk.WorkflowDefinition, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("workflow definition not found in state: %s", resourceName)
+	}
+
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no workflow definition ID found")
+	}
+
+	spaceID := rs.Primary.Attributes["space_id"]
+	if spaceID == "" {
+		return nil, fmt.Errorf("no space_id is set")
+	}
+
+	environment := rs.Primary.Attributes["environment"]
+	if environment == "" {
+		return nil, fmt.Errorf("no environment is set")
+	}
+
+	client := acctest.GetClient()
+	resp, err := client.GetWorkflowDefinitionWithResponse(context.Background(), spaceID, environment, rs.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("workflow definition not found: %s", rs.Primary.ID)
+	}
+
+	return resp.JSON200, nil
+}
+
+func testAccCheckContentfulWorkflowDefinitionDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_workflow_definition" {
+			continue
+		}
+
+		spaceID := rs.Primary.Attributes["space_id"]
+		if spaceID == "" {
+			return fmt.Errorf("no space_id is set")
+		}
+
+		environment := rs.Primary.Attributes["environment"]
+		if environment == "" {
+			return fmt.Errorf("no environment is set")
+		}
+
+		resp, err := client.GetWorkflowDefinitionWithResponse(context.Background(), spaceID, environment, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("workflow definition still exists with id: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testWorkflowDefinitionConfig(spaceID, environment, name, firstStep string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id = "%s"
+  environment = "%s"
+  id = "tf_workflow_test"
+  name = "tf_workflow_test"
+  description = "Terraform Acc Test Content Type"
+  display_field = "title"
+
+  fields = [
+    {
+      id       = "title"
+      name     = "Title"
+      type     = "Symbol"
+      required = true
+    }
+  ]
+}
+
+resource "contentful_workflow_definition" "myworkflow" {
+  space_id = "%s"
+  environment = "%s"
+  name = "%s"
+  description = "Terraform Acc Test Workflow"
+  content_types = [contentful_contenttype.mycontenttype.id]
+
+  steps = [
+    {
+      name  = "%s"
+      color = "blue"
+      permissions = [
+        {
+          action = "publish"
+          effect = "deny"
+        }
+      ]
+    },
+    {
+      name  = "Approved"
+      color = "green"
+    }
+  ]
+}
+`, spaceID, environment, spaceID, environment, name, firstStep)
+}
@@ -33,6 +33,40 @@ const (
 	ApiKeyCollectionSysTypeArray ApiKeyCollectionSysType = "Array"
 )
 
+// Defines values for AppActionCategory.
+const (
+	AppActionCategoryCustom          AppActionCategory = "Custom"
+	AppActionCategoryEntriesV10      AppActionCategory = "Entries.v1.0"
+	AppActionCategoryNotificationV10 AppActionCategory = "Notification.v1.0"
+)
+
+// Defines values for AppActionType.
+const (
+	AppActionTypeEndpoint AppActionType = "endpoint"
+	AppActionTypeFunction AppActionType = "function"
+)
+
+// Defines values for AppActionDraftCategory.
+const (
+	AppActionDraftCategoryCustom          AppActionDraftCategory = "Custom"
+	AppActionDraftCategoryEntriesV10      AppActionDraftCategory = "Entries.v1.0"
+	AppActionDraftCategoryNotificationV10 AppActionDraftCategory = "Notification.v1.0"
+)
+
+// Defines values for AppActionDraftType.
+const (
+	AppActionDraftTypeEndpoint AppActionDraftType = "endpoint"
+	AppActionDraftTypeFunction AppActionDraftType = "function"
+)
+
+// Defines values for AppActionParameterType.
+const (
+	AppActionParameterTypeBoolean AppActionParameterType = "Boolean"
+	AppActionParameterTypeEnum    AppActionParameterType = "Enum"
+	AppActionParameterTypeNumber  AppActionParameterType = "Number"
+	AppActionParameterTypeSymbol  AppActionParameterType = "Symbol"
+)
+
 // Defines values for AppDefinitionParametersInstallationParameterType.
 const (
 	AppDefinitionParametersInstallationParameterTypeBoolean AppDefinitionParametersInstallationParameterType = "Boolean"
@@ -88,6 +122,21 @@ const (
 	AppInstallationCollectionSysTypeArray AppInstallationCollectionSysType = "Array"
 )
 
+// Defines values for AppKeyJwkAlg.
+const (
+	RS256 AppKeyJwkAlg = "RS256"
+)
+
+// Defines values for AppKeyJwkKty.
+const (
+	RSA AppKeyJwkKty = "RSA"
+)
+
+// Defines values for AppKeyJwkUse.
+const (
+	Sig AppKeyJwkUse = "sig"
+)
+
 // Defines values for AssetCollectionSysType.
 const (
 	AssetCollectionSysTypeArray AssetCollectionSysType = "Array"
@@ -114,9 +163,9 @@ const (
 
 // Defines values for EditorInterfaceSidebarItemWidgetNamespace.
 const (
-	App       EditorInterfaceSidebarItemWidgetNamespace = "app"
-	Builtin   EditorInterfaceSidebarItemWidgetNamespace = "builtin"
-	Extension EditorInterfaceSidebarItemWidgetNamespace = "extension"
+	EditorInterfaceSidebarItemWidgetNamespaceApp       EditorInterfaceSidebarItemWidgetNamespace = "app"
+	EditorInterfaceSidebarItemWidgetNamespaceBuiltin   EditorInterfaceSidebarItemWidgetNamespace = "builtin"
+	EditorInterfaceSidebarItemWidgetNamespaceExtension EditorInterfaceSidebarItemWidgetNamespace = "extension"
 )
 
 // Defines values for EntryCollectionSysType.
@@ -190,6 +239,23 @@ const (
 	PreviewApiKeyCollectionSysTypeArray PreviewApiKeyCollectionSysType = "Array"
 )
 
+// Defines values for ReleaseActionAction.
+const (
+	ReleaseActionActionPublish   ReleaseActionAction = "publish"
+	ReleaseActionActionUnpublish ReleaseActionAction = "unpublish"
+	ReleaseActionActionValidate  ReleaseActionAction = "validate"
+)
+
+// Defines values for ReleaseEntitiesSysType.
+const (
+	ReleaseEntitiesSysTypeArray ReleaseEntitiesSysType = "Array"
+)
+
+// Defines values for ReleaseValidateAction.
+const (
+	ReleaseValidateActionPublish ReleaseValidateAction = "publish"
+)
+
 // Defines values for SpaceCollectionSysType.
 const (
 	SpaceCollectionSysTypeArray SpaceCollectionSysType = "Array"
@@ -210,9 +276,52 @@ const (
 	SystemPropertiesPreviewEnvironmentTypePreviewEnvironment SystemPropertiesPreviewEnvironmentType = "PreviewEnvironment"
 )
 
+// Defines values for SystemPropertiesReleaseActionStatus.
+const (
+	Created    SystemPropertiesReleaseActionStatus = "created"
+	Failed     SystemPropertiesReleaseActionStatus = "failed"
+	InProgress SystemPropertiesReleaseActionStatus = "inProgress"
+	Succeeded  SystemPropertiesReleaseActionStatus = "succeeded"
+)
+
 // Defines values for WebhookCollectionSysType.
 const (
-	WebhookCollectionSysTypeArray WebhookCollectionSysType = "Array"
+	Array WebhookCollectionSysType = "Array"
+)
+
+// Defines values for WorkflowDefinitionAppliesToLinkType.
+const (
+	WorkflowDefinitionAppliesToLinkTypeEntry WorkflowDefinitionAppliesToLinkType = "Entry"
+)
+
+// Defines values for WorkflowDefinitionAppliesToType.
+const (
+	WorkflowDefinitionAppliesToTypeLink WorkflowDefinitionAppliesToType = "Link"
+)
+
+// Defines values for WorkflowStepActionType.
+const (
+	WorkflowStepActionTypeApp   WorkflowStepActionType = "app"
+	WorkflowStepActionTypeEmail WorkflowStepActionType = "email"
+	WorkflowStepActionTypeTask  WorkflowStepActionType = "task"
+)
+
+// Defines values for WorkflowStepPermissionType.
+const (
+	EntityPermission WorkflowStepPermissionType = "entity_permission"
+)
+
+// Defines values for WorkflowStepPermissionConfigurationAction.
+const (
+	Delete  WorkflowStepPermissionConfigurationAction = "delete"
+	Edit    WorkflowStepPermissionConfigurationAction = "edit"
+	Publish WorkflowStepPermissionConfigurationAction = "publish"
+)
+
+// Defines values for WorkflowStepPermissionConfigurationEffect.
+const (
+	Allow WorkflowStepPermissionConfigurationEffect = "allow"
+	Deny  WorkflowStepPermissionConfigurationEffect = "deny"
 )
 
 // AllowedResource defines model for AllowedResource.
@@ -222,6 +331,13 @@ type AllowedResource struct {
 	Type         *string   `json:"type,omitempty"`
 }
 
+// AnnotationLink defines model for AnnotationLink.
+type AnnotationLink struct {
+	// Parameters Parameters of the annotation
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+	Sys        SystemPropertiesLink    `json:"sys"`
+}
+
 // ApiKey defines model for ApiKey.
 type ApiKey struct {
 	// AccessToken The Content Delivery API access token
@@ -280,6 +396,90 @@ type ApiKeyDraft struct {
 	Name string `json:"name"`
 }
 
+// AppAction defines model for AppAction.
+type AppAction struct {
+	// Category Category of the app action
+	Category AppActionCategory `json:"category"`
+
+	// Description Description of the app action
+	Description *string                    `json:"description,omitempty"`
+	Function    *SystemPropertiesReference `json:"function,omitempty"`
+
+	// Name Name of the app action
+	Name       string                `json:"name"`
+	Parameters *[]AppActionParameter `json:"parameters,omitempty"`
+	Sys        SystemPropertiesBase  `json:"sys"`
+
+	// Type Type of the app action
+	Type AppActionType `json:"type"`
+
+	// Url URL that is called for endpoint app actions
+	Url *string `json:"url,omitempty"`
+}
+
+// AppActionCategory Category of the app action
+type AppActionCategory string
+
+// AppActionType Type of the app action
+type AppActionType string
+
+// AppActionDraft defines model for AppActionDraft.
+type AppActionDraft struct {
+	// Category Category of the app action
+	Category AppActionDraftCategory `json:"category"`
+
+	// Description Description of the app action
+	Description *string                    `json:"description,omitempty"`
+	Function    *SystemPropertiesReference `json:"function,omitempty"`
+
+	// Name Name of the app action
+	Name       string                `json:"name"`
+	Parameters *[]AppActionParameter `json:"parameters,omitempty"`
+
+	// Type Type of the app action
+	Type AppActionDraftType `json:"type"`
+
+	// Url URL that is called for endpoint app actions
+	Url *string `json:"url,omitempty"`
+}
+
+// AppActionDraftCategory Category of the app action
+type AppActionDraftCategory string
+
+// AppActionDraftType Type of the app action
+type AppActionDraftType string
+
+// AppActionParameter defines model for AppActionParameter.
+type AppActionParameter struct {
+	// Description Description of the parameter
+	Description *string `json:"description,omitempty"`
+
+	// Id ID of the parameter
+	Id string `json:"id"`
+
+	// Name Name of the parameter
+	Name string `json:"name"`
+
+	// Options Allowed values for Enum parameters
+	Options *[]string `json:"options,omitempty"`
+
+	// Required Whether the parameter is required
+	Required *bool `json:"required,omitempty"`
+
+	// Type Type of the parameter
+	Type AppActionParameterType `json:"type"`
+}
+
+// AppActionParameterType Type of the parameter
+type AppActionParameterType string
+
+// AppBundle defines model for AppBundle.
+type AppBundle struct {
+	// Comment Name of the app bundle
+	Comment *string              `json:"comment,omitempty"`
+	Sys     SystemPropertiesBase `json:"sys"`
+}
+
 // AppBundleDraft defines model for AppBundleDraft.
 type AppBundleDraft struct {
 	// Comment Name of the app bundle
@@ -468,6 +668,49 @@ type AppInstallationUpsert struct {
 	Srcdoc *string `json:"srcdoc,omitempty"`
 }
 
+// AppKey defines model for AppKey.
+type AppKey struct {
+	// Generated Only returned when the key pair was generated by Contentful
+	Generated *struct {
+		// PrivateKey The PEM encoded private key
+		PrivateKey string `json:"privateKey"`
+	} `json:"generated,omitempty"`
+	Jwk AppKeyJwk            `json:"jwk"`
+	Sys SystemPropertiesBase `json:"sys"`
+}
+
+// AppKeyDraft defines model for AppKeyDraft.
+type AppKeyDraft struct {
+	// Generate Let Contentful generate a new key pair
+	Generate *bool      `json:"generate,omitempty"`
+	Jwk      *AppKeyJwk `json:"jwk,omitempty"`
+}
+
+// AppKeyJwk defines model for AppKeyJwk.
+type AppKeyJwk struct {
+	Alg AppKeyJwkAlg `json:"alg"`
+
+	// Kid The key ID
+	Kid string       `json:"kid"`
+	Kty AppKeyJwkKty `json:"kty"`
+	Use AppKeyJwkUse `json:"use"`
+
+	// X5c The base64 encoded DER certificate containing the public key
+	X5c []string `json:"x5c"`
+
+	// X5t The base64url encoded SHA-1 thumbprint of the certificate
+	X5t string `json:"x5t"`
+}
+
+// AppKeyJwkAlg defines model for AppKeyJwk.Alg.
+type AppKeyJwkAlg string
+
+// AppKeyJwkKty defines model for AppKeyJwk.Kty.
+type AppKeyJwkKty string
+
+// AppKeyJwkUse defines model for AppKeyJwk.Use.
+type AppKeyJwkUse string
+
 // AppLocation defines model for AppLocation.
 type AppLocation struct {
 	// FieldTypes Field types that this app can be installed on (for entry-field location)
@@ -484,6 +727,19 @@ type AppNavigationItem struct {
 	Path string `json:"path"`
 }
 
+// AppSigningSecret defines model for AppSigningSecret.
+type AppSigningSecret struct {
+	// RedactedValue The last four characters of the signing secret
+	RedactedValue string                `json:"redactedValue"`
+	Sys           *SystemPropertiesBase `json:"sys,omitempty"`
+}
+
+// AppSigningSecretDraft defines model for AppSigningSecretDraft.
+type AppSigningSecretDraft struct {
+	// Value The signing secret, 64 alphanumeric characters
+	Value string `json:"value"`
+}
+
 // Asset defines model for Asset.
 type Asset struct {
 	Fields struct {
@@ -492,23 +748,20 @@ type Asset struct {
 
 		// File Asset file details by locale
 		File map[string]struct {
-			ContentType string `json:"contentType"`
-			Details     *struct {
-				Image *struct {
-					Height *int64 `json:"height,omitempty"`
-					Width  *int64 `json:"width,omitempty"`
-				} `json:"image,omitempty"`
-				Size *int64 `json:"size,omitempty"`
-			} `json:"details,omitempty"`
-			FileName string  `json:"fileName"`
-			Upload   *string `json:"upload,omitempty"`
-			Url      *string `json:"url,omitempty"`
+			ContentType string            `json:"contentType"`
+			Details     *AssetFileDetails `json:"details,omitempty"`
+			FileName    string            `json:"fileName"`
+			Upload      *string           `json:"upload,omitempty"`
+			Url         *string           `json:"url,omitempty"`
 		} `json:"file"`
 
 		// Title Asset title by locale
 		Title map[string]string `json:"title"`
 	} `json:"fields"`
-	Sys SystemPropertiesContent `json:"sys"`
+
+	// Metadata Tags and taxonomy concepts of an entry or asset
+	Metadata *ContentMetadata        `json:"metadata,omitempty"`
+	Sys      SystemPropertiesContent `json:"sys"`
 }
 
 // AssetCollection defines model for AssetCollection.
@@ -534,6 +787,9 @@ type AssetCollectionSysType string
 // AssetCreate defines model for AssetCreate.
 type AssetCreate struct {
 	Fields *AssetField `json:"fields,omitempty"`
+
+	// Metadata Tags and taxonomy concepts of an entry or asset
+	Metadata *ContentMetadata `json:"metadata,omitempty"`
 }
 
 // AssetField defines model for AssetField.
@@ -548,11 +804,28 @@ type AssetField struct {
 
 // AssetFile Asset file details by locale
 type AssetFile struct {
-	ContentType string `json:"contentType"`
-	FileName    string `json:"fileName"`
+	ContentType string            `json:"contentType"`
+	Details     *AssetFileDetails `json:"details,omitempty"`
+	FileName    string            `json:"fileName"`
 
 	// Upload Upload URL
-	Upload string `json:"upload"`
+	Upload     *string                    `json:"upload,omitempty"`
+	UploadFrom *SystemPropertiesReference `json:"uploadFrom,omitempty"`
+
+	// Url URL of an already processed file
+	Url *string `json:"url,omitempty"`
+}
+
+// AssetFileDetails defines model for AssetFileDetails.
+type AssetFileDetails struct {
+	Image *AssetFileImageDetails `json:"image,omitempty"`
+	Size  *int64                 `json:"size,omitempty"`
+}
+
+// AssetFileImageDetails defines model for AssetFileImageDetails.
+type AssetFileImageDetails struct {
+	Height *int64 `json:"height,omitempty"`
+	Width  *int64 `json:"width,omitempty"`
 }
 
 // AssetHyperlinkValidation defines model for AssetHyperlinkValidation.
@@ -561,6 +834,15 @@ type AssetHyperlinkValidation struct {
 	Size    *RangeMinMax `json:"size,omitempty"`
 }
 
+// ContentMetadata Tags and taxonomy concepts of an entry or asset
+type ContentMetadata struct {
+	// Concepts Links to the taxonomy concepts of the entry or asset
+	Concepts *[]SystemPropertiesReference `json:"concepts,omitempty"`
+
+	// Tags Links to the tags of the entry or asset
+	Tags *[]SystemPropertiesReference `json:"tags,omitempty"`
+}
+
 // ContentType defines model for ContentType.
 type ContentType struct {
 	// Description Description of the content type
@@ -570,11 +852,23 @@ type ContentType struct {
 	DisplayField *string `json:"displayField,omitempty"`
 	Fields       []Field `json:"fields"`
 
+	// Metadata Annotations and taxonomy validations of a content type
+	Metadata *ContentTypeMetadata `json:"metadata,omitempty"`
+
 	// Name Name of the content type
 	Name string                   `json:"name"`
 	Sys  SystemPropertiesResource `json:"sys"`
 }
 
+// ContentTypeAnnotations defines model for ContentTypeAnnotations.
+type ContentTypeAnnotations struct {
+	// ContentType Annotations of the content type
+	ContentType *[]AnnotationLink `json:"ContentType,omitempty"`
+
+	// ContentTypeField Annotations of the fields by field ID
+	ContentTypeField *map[string][]AnnotationLink `json:"ContentTypeField,omitempty"`
+}
+
 // ContentTypeCollection defines model for ContentTypeCollection.
 type ContentTypeCollection struct {
 	Items []ContentType `json:"items"`
@@ -604,10 +898,21 @@ type ContentTypeCreate struct {
 	DisplayField *string `json:"displayField,omitempty"`
 	Fields       []Field `json:"fields"`
 
+	// Metadata Annotations and taxonomy validations of a content type
+	Metadata *ContentTypeMetadata `json:"metadata,omitempty"`
+
 	// Name Name of the content type
 	Name string `json:"name"`
 }
 
+// ContentTypeMetadata Annotations and taxonomy validations of a content type
+type ContentTypeMetadata struct {
+	Annotations *ContentTypeAnnotations `json:"annotations,omitempty"`
+
+	// Taxonomy Taxonomy concepts and concept schemes that entries of the content type can be tagged with
+	Taxonomy *[]TaxonomyValidation `json:"taxonomy,omitempty"`
+}
+
 // ContentTypeUpdate defines model for ContentTypeUpdate.
 type ContentTypeUpdate struct {
 	// Description Description of the content type
@@ -617,6 +922,9 @@ type ContentTypeUpdate struct {
 	DisplayField *string `json:"displayField,omitempty"`
 	Fields       []Field `json:"fields"`
 
+	// Metadata Annotations and taxonomy validations of a content type
+	Metadata *ContentTypeMetadata `json:"metadata,omitempty"`
+
 	// Name Name of the content type
 	Name string `json:"name"`
 }
@@ -775,7 +1083,10 @@ type EmbeddedValidation struct {
 type Entry struct {
 	// Fields Content fields with values by locale
 	Fields orderedmap.OrderedMap `json:"fields"`
-	Sys    SystemPropertiesEntry `json:"sys"`
+
+	// Metadata Tags and taxonomy concepts of an entry or asset
+	Metadata *ContentMetadata      `json:"metadata,omitempty"`
+	Sys      SystemPropertiesEntry `json:"sys"`
 }
 
 // EntryCollection defines model for EntryCollection.
@@ -802,6 +1113,9 @@ type EntryCollectionSysType string
 type EntryDraft struct {
 	// Fields Content fields with values by locale
 	Fields *orderedmap.OrderedMap `json:"fields,omitempty"`
+
+	// Metadata Tags and taxonomy concepts of an entry or asset
+	Metadata *ContentMetadata `json:"metadata,omitempty"`
 }
 
 // EntryHyperlinkValidation defines model for EntryHyperlinkValidation.
@@ -934,6 +1248,9 @@ type Field struct {
 	// Name Name of the field
 	Name string `json:"name"`
 
+	// NewId New ID of the field, to rename the field and keep the values of the entries
+	NewId *string `json:"newId,omitempty"`
+
 	// Omitted Whether the field is omitted
 	Omitted *bool `json:"omitted,omitempty"`
 
@@ -1009,11 +1326,12 @@ type FieldValidation struct {
 	LinkMimetypeGroup *[]string `json:"linkMimetypeGroup,omitempty"`
 
 	// Message Custom error message
-	Message *string               `json:"message,omitempty"`
-	Nodes   *NodesValidation      `json:"nodes,omitempty"`
-	Range   *RangeMinMax          `json:"range,omitempty"`
-	Regexp  *RegexValidationValue `json:"regexp,omitempty"`
-	Size    *RangeMinMax          `json:"size,omitempty"`
+	Message        *string               `json:"message,omitempty"`
+	Nodes          *NodesValidation      `json:"nodes,omitempty"`
+	ProhibitRegexp *RegexValidationValue `json:"prohibitRegexp,omitempty"`
+	Range          *RangeMinMax          `json:"range,omitempty"`
+	Regexp         *RegexValidationValue `json:"regexp,omitempty"`
+	Size           *RangeMinMax          `json:"size,omitempty"`
 
 	// Unique Whether the field value must be unique
 	Unique *bool `json:"unique,omitempty"`
@@ -1088,6 +1406,18 @@ type LocaleCreate struct {
 	Optional *bool `json:"optional,omitempty"`
 }
 
+// LocalePublishing Locales to publish and unpublish, sent with the request to publish an entry or asset
+type LocalePublishing struct {
+	Add    *LocalePublishingFields `json:"add,omitempty"`
+	Remove *LocalePublishingFields `json:"remove,omitempty"`
+}
+
+// LocalePublishingFields defines model for LocalePublishingFields.
+type LocalePublishingFields struct {
+	// Fields Locale codes by field ID, the field ID * applies to all fields
+	Fields map[string][]string `json:"fields"`
+}
+
 // LocaleUpdate defines model for LocaleUpdate.
 type LocaleUpdate struct {
 	// Code Locale code (e.g., en-US, de-DE)
@@ -1197,11 +1527,11 @@ type PreviewEnvironmentInput struct {
 
 // RangeDate defines model for RangeDate.
 type RangeDate struct {
-	// Max Maximum date
-	Max *time.Time `json:"max,omitempty"`
+	// Max Maximum date, an ISO 8601 date with an optional time
+	Max *string `json:"max,omitempty"`
 
-	// Min Minimum date
-	Min *time.Time `json:"min,omitempty"`
+	// Min Minimum date, an ISO 8601 date with an optional time
+	Min *string `json:"min,omitempty"`
 }
 
 // RangeImageDimensions defines model for RangeImageDimensions.
@@ -1237,6 +1567,74 @@ type RegexValidationValue struct {
 	Pattern string `json:"pattern"`
 }
 
+// Release defines model for Release.
+type Release struct {
+	Entities ReleaseEntities          `json:"entities"`
+	Sys      SystemPropertiesResource `json:"sys"`
+
+	// Title Title of the release
+	Title string `json:"title"`
+}
+
+// ReleaseAction defines model for ReleaseAction.
+type ReleaseAction struct {
+	// Action The action that is performed on the release
+	Action ReleaseActionAction           `json:"action"`
+	Error  *ReleaseActionError           `json:"error,omitempty"`
+	Sys    SystemPropertiesReleaseAction `json:"sys"`
+}
+
+// ReleaseActionAction The action that is performed on the release
+type ReleaseActionAction string
+
+// ReleaseActionEntityError defines model for ReleaseActionEntityError.
+type ReleaseActionEntityError struct {
+	Entity SystemPropertiesReference `json:"entity"`
+
+	// Error The error returned for the entity
+	Error map[string]interface{} `json:"error"`
+}
+
+// ReleaseActionError defines model for ReleaseActionError.
+type ReleaseActionError struct {
+	Details *struct {
+		Errors *[]ReleaseActionEntityError `json:"errors,omitempty"`
+	} `json:"details,omitempty"`
+
+	// Message Error message
+	Message *string              `json:"message,omitempty"`
+	Sys     SystemPropertiesBase `json:"sys"`
+}
+
+// ReleaseDraft defines model for ReleaseDraft.
+type ReleaseDraft struct {
+	Entities ReleaseEntities `json:"entities"`
+
+	// Title Title of the release
+	Title string `json:"title"`
+}
+
+// ReleaseEntities defines model for ReleaseEntities.
+type ReleaseEntities struct {
+	// Items Links to the entries and assets in the release
+	Items []SystemPropertiesReference `json:"items"`
+	Sys   struct {
+		Type ReleaseEntitiesSysType `json:"type"`
+	} `json:"sys"`
+}
+
+// ReleaseEntitiesSysType defines model for ReleaseEntities.Sys.Type.
+type ReleaseEntitiesSysType string
+
+// ReleaseValidate defines model for ReleaseValidate.
+type ReleaseValidate struct {
+	// Action The action to validate the release for
+	Action *ReleaseValidateAction `json:"action,omitempty"`
+}
+
+// ReleaseValidateAction The action to validate the release for
+type ReleaseValidateAction string
+
 // ResourceHyperlinkValidation defines model for ResourceHyperlinkValidation.
 type ResourceHyperlinkValidation struct {
 	AllowedResources *[]AllowedResource    `json:"allowedResources,omitempty"`
@@ -1431,6 +1829,9 @@ type SystemPropertiesContent struct {
 	CreatedBy   SystemPropertiesReference  `json:"createdBy"`
 	Environment *SystemPropertiesReference `json:"environment,omitempty"`
 
+	// FieldStatus Publishing status of the locales, when locales are published separately
+	FieldStatus *map[string]map[string]string `json:"fieldStatus,omitempty"`
+
 	// Id Resource ID
 	Id string `json:"id"`
 
@@ -1457,12 +1858,18 @@ type SystemPropertiesEntry struct {
 	CreatedBy   SystemPropertiesReference  `json:"createdBy"`
 	Environment *SystemPropertiesReference `json:"environment,omitempty"`
 
+	// FieldStatus Publishing status of the locales, when locales are published separately
+	FieldStatus *map[string]map[string]string `json:"fieldStatus,omitempty"`
+
 	// Id Resource ID
 	Id string `json:"id"`
 
 	// PublishedAt Publication timestamp
-	PublishedAt *time.Time                `json:"publishedAt,omitempty"`
-	Space       SystemPropertiesReference `json:"space"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+
+	// PublishedVersion Version of the entry that was published last
+	PublishedVersion *int64                    `json:"publishedVersion,omitempty"`
+	Space            SystemPropertiesReference `json:"space"`
 
 	// Type Resource type
 	Type string `json:"type"`
@@ -1512,6 +1919,28 @@ type SystemPropertiesReference struct {
 	Sys SystemPropertiesLink `json:"sys"`
 }
 
+// SystemPropertiesReleaseAction defines model for SystemPropertiesReleaseAction.
+type SystemPropertiesReleaseAction struct {
+	// CreatedAt Creation timestamp
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Id Resource ID
+	Id      string                    `json:"id"`
+	Release SystemPropertiesReference `json:"release"`
+
+	// Status Status of the release action
+	Status SystemPropertiesReleaseActionStatus `json:"status"`
+
+	// Type Resource type
+	Type string `json:"type"`
+
+	// UpdatedAt Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SystemPropertiesReleaseActionStatus Status of the release action
+type SystemPropertiesReleaseActionStatus string
+
 // SystemPropertiesResource defines model for SystemPropertiesResource.
 type SystemPropertiesResource struct {
 	CreatedBy   SystemPropertiesReference  `json:"createdBy"`
@@ -1544,6 +1973,35 @@ type SystemPropertiesSpace struct {
 	Version int64 `json:"version"`
 }
 
+// SystemPropertiesUpload defines model for SystemPropertiesUpload.
+type SystemPropertiesUpload struct {
+	// CreatedAt Creation timestamp
+	CreatedAt   *time.Time                 `json:"createdAt,omitempty"`
+	Environment *SystemPropertiesReference `json:"environment,omitempty"`
+
+	// ExpiresAt Expiration timestamp, uploads are removed 48 hours after creation
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Id Resource ID
+	Id    string                     `json:"id"`
+	Space *SystemPropertiesReference `json:"space,omitempty"`
+
+	// Type Resource type
+	Type string `json:"type"`
+}
+
+// TaxonomyValidation defines model for TaxonomyValidation.
+type TaxonomyValidation struct {
+	// Required Whether entries have to be tagged with the concept or a concept of the scheme
+	Required *bool                `json:"required,omitempty"`
+	Sys      SystemPropertiesLink `json:"sys"`
+}
+
+// Upload defines model for Upload.
+type Upload struct {
+	Sys SystemPropertiesUpload `json:"sys"`
+}
+
 // Webhook defines model for Webhook.
 type Webhook struct {
 	// Active Whether the webhook is active
@@ -1652,9 +2110,122 @@ type WebhookUpdate struct {
 	Url string `json:"url"`
 }
 
+// WorkflowDefinition defines model for WorkflowDefinition.
+type WorkflowDefinition struct {
+	AppliesTo *[]WorkflowDefinitionAppliesTo `json:"appliesTo,omitempty"`
+
+	// Description Description of the workflow definition
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the workflow definition
+	Name  string                   `json:"name"`
+	Steps []WorkflowStep           `json:"steps"`
+	Sys   SystemPropertiesResource `json:"sys"`
+}
+
+// WorkflowDefinitionAppliesTo defines model for WorkflowDefinitionAppliesTo.
+type WorkflowDefinitionAppliesTo struct {
+	LinkType    WorkflowDefinitionAppliesToLinkType     `json:"linkType"`
+	Type        WorkflowDefinitionAppliesToType         `json:"type"`
+	Validations []WorkflowDefinitionAppliesToValidation `json:"validations"`
+}
+
+// WorkflowDefinitionAppliesToLinkType defines model for WorkflowDefinitionAppliesTo.LinkType.
+type WorkflowDefinitionAppliesToLinkType string
+
+// WorkflowDefinitionAppliesToType defines model for WorkflowDefinitionAppliesTo.Type.
+type WorkflowDefinitionAppliesToType string
+
+// WorkflowDefinitionAppliesToValidation defines model for WorkflowDefinitionAppliesToValidation.
+type WorkflowDefinitionAppliesToValidation struct {
+	LinkContentType *[]string `json:"linkContentType,omitempty"`
+}
+
+// WorkflowDefinitionDraft defines model for WorkflowDefinitionDraft.
+type WorkflowDefinitionDraft struct {
+	AppliesTo *[]WorkflowDefinitionAppliesTo `json:"appliesTo,omitempty"`
+
+	// Description Description of the workflow definition
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the workflow definition
+	Name  string         `json:"name"`
+	Steps []WorkflowStep `json:"steps"`
+}
+
+// WorkflowStep defines model for WorkflowStep.
+type WorkflowStep struct {
+	Actions *[]WorkflowStepAction `json:"actions,omitempty"`
+
+	// Annotations Annotations of the step, for example the color
+	Annotations *[]string `json:"annotations,omitempty"`
+
+	// Description Description of the step
+	Description *string `json:"description,omitempty"`
+
+	// Id ID of the step
+	Id *string `json:"id,omitempty"`
+
+	// Name Name of the step
+	Name        string                    `json:"name"`
+	Permissions *[]WorkflowStepPermission `json:"permissions,omitempty"`
+}
+
+// WorkflowStepAction defines model for WorkflowStepAction.
+type WorkflowStepAction struct {
+	// AppActionId ID of the app action, only for app actions
+	AppActionId *string `json:"appActionId,omitempty"`
+
+	// AppId ID of the app definition, only for app actions
+	AppId         *string                 `json:"appId,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+
+	// Type Type of the action
+	Type WorkflowStepActionType `json:"type"`
+}
+
+// WorkflowStepActionType Type of the action
+type WorkflowStepActionType string
+
+// WorkflowStepPermission defines model for WorkflowStepPermission.
+type WorkflowStepPermission struct {
+	Configuration WorkflowStepPermissionConfiguration `json:"configuration"`
+	Type          WorkflowStepPermissionType          `json:"type"`
+}
+
+// WorkflowStepPermissionType defines model for WorkflowStepPermission.Type.
+type WorkflowStepPermissionType string
+
+// WorkflowStepPermissionConfiguration defines model for WorkflowStepPermissionConfiguration.
+type WorkflowStepPermissionConfiguration struct {
+	Action WorkflowStepPermissionConfigurationAction `json:"action"`
+
+	// Actors Either the string "all" or a list of links to users and roles
+	Actors interface{}                               `json:"actors"`
+	Effect WorkflowStepPermissionConfigurationEffect `json:"effect"`
+}
+
+// WorkflowStepPermissionConfigurationAction defines model for WorkflowStepPermissionConfiguration.Action.
+type WorkflowStepPermissionConfigurationAction string
+
+// WorkflowStepPermissionConfigurationEffect defines model for WorkflowStepPermissionConfiguration.Effect.
+type WorkflowStepPermissionConfigurationEffect string
+
+// AliasId defines model for aliasId.
+type AliasId = string
+
 // ApiKeyId defines model for apiKeyId.
 type ApiKeyId = string
 
+// AppActionId defines model for appActionId.
+type AppActionId = string
+
+// AppBundleId defines model for appBundleId.
+type AppBundleId = string
+
+// AppKeyId defines model for appKeyId.
+type AppKeyId = string
+
 // ContentTypeHeader defines model for contentTypeHeader.
 type ContentTypeHeader = string
 
@@ -1679,6 +2250,12 @@ type LocaleId = string
 // OrganizationId defines model for organizationId.
 type OrganizationId = string
 
+// ReleaseActionId defines model for releaseActionId.
+type ReleaseActionId = string
+
+// ReleaseId defines model for releaseId.
+type ReleaseId = string
+
 // ResourceId defines model for resourceId.
 type ResourceId = string
 
@@ -1697,6 +2274,9 @@ type SpaceId = string
 // WebhookId defines model for webhookId.
 type WebhookId = string
 
+// WorkflowDefinitionId defines model for workflowDefinitionId.
+type WorkflowDefinitionId = string
+
 // GetAllAppDefinitionsParams defines parameters for GetAllAppDefinitions.
 type GetAllAppDefinitionsParams struct {
 	// Limit Maximum number of items to return
@@ -1984,6 +2564,36 @@ type UpdateLocaleParams struct {
 	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
 }
 
+// UpdateReleaseParams defines parameters for UpdateRelease.
+type UpdateReleaseParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// UnpublishReleaseParams defines parameters for UnpublishRelease.
+type UnpublishReleaseParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// PublishReleaseParams defines parameters for PublishRelease.
+type PublishReleaseParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// DeleteWorkflowDefinitionParams defines parameters for DeleteWorkflowDefinition.
+type DeleteWorkflowDefinitionParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// UpdateWorkflowDefinitionParams defines parameters for UpdateWorkflowDefinition.
+type UpdateWorkflowDefinitionParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
 // GetAllPreviewApiKeysParams defines parameters for GetAllPreviewApiKeys.
 type GetAllPreviewApiKeysParams struct {
 	// Limit Maximum number of items to return
@@ -2053,13 +2663,25 @@ type CreateAppDefinitionJSONRequestBody = AppDefinitionDraft
 // UpdateAppDefinitionJSONRequestBody defines body for UpdateAppDefinition for application/json ContentType.
 type UpdateAppDefinitionJSONRequestBody = AppDefinitionDraft
 
+// CreateAppActionJSONRequestBody defines body for CreateAppAction for application/json ContentType.
+type CreateAppActionJSONRequestBody = AppActionDraft
+
+// UpdateAppActionJSONRequestBody defines body for UpdateAppAction for application/json ContentType.
+type UpdateAppActionJSONRequestBody = AppActionDraft
+
 // CreateAppBundleJSONRequestBody defines body for CreateAppBundle for application/json ContentType.
 type CreateAppBundleJSONRequestBody = AppBundleDraft
 
 // UpdateAppEventSubscriptionJSONRequestBody defines body for UpdateAppEventSubscription for application/json ContentType.
 type UpdateAppEventSubscriptionJSONRequestBody = AppEventSubscriptionDraft
 
-// CreateSpaceJSONRequestBody defines body for CreateSpace for application/json ContentType.
+// CreateAppKeyJSONRequestBody defines body for CreateAppKey for application/json ContentType.
+type CreateAppKeyJSONRequestBody = AppKeyDraft
+
+// UpdateAppSigningSecretJSONRequestBody defines body for UpdateAppSigningSecret for application/json ContentType.
+type UpdateAppSigningSecretJSONRequestBody = AppSigningSecretDraft
+
+// CreateSpaceJSONRequestBody defines body for CreateSpace for application/json ContentType.
 type CreateSpaceJSONRequestBody = SpaceCreate
 
 // UpdateSpaceJSONRequestBody defines body for UpdateSpace for application/json ContentType.
@@ -2110,6 +2732,21 @@ type CreateLocaleJSONRequestBody = LocaleCreate
 // UpdateLocaleJSONRequestBody defines body for UpdateLocale for application/json ContentType.
 type UpdateLocaleJSONRequestBody = LocaleUpdate
 
+// CreateReleaseJSONRequestBody defines body for CreateRelease for application/json ContentType.
+type CreateReleaseJSONRequestBody = ReleaseDraft
+
+// UpdateReleaseJSONRequestBody defines body for UpdateRelease for application/json ContentType.
+type UpdateReleaseJSONRequestBody = ReleaseDraft
+
+// ValidateReleaseJSONRequestBody defines body for ValidateRelease for application/json ContentType.
+type ValidateReleaseJSONRequestBody = ReleaseValidate
+
+// CreateWorkflowDefinitionJSONRequestBody defines body for CreateWorkflowDefinition for application/json ContentType.
+type CreateWorkflowDefinitionJSONRequestBody = WorkflowDefinitionDraft
+
+// UpdateWorkflowDefinitionJSONRequestBody defines body for UpdateWorkflowDefinition for application/json ContentType.
+type UpdateWorkflowDefinitionJSONRequestBody = WorkflowDefinitionDraft
+
 // CreatePreviewEnvironmentJSONRequestBody defines body for CreatePreviewEnvironment for application/json ContentType.
 type CreatePreviewEnvironmentJSONRequestBody = PreviewEnvironmentInput
 
@@ -2482,11 +3119,33 @@ type ClientInterface interface {
 
 	UpdateAppDefinition(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateAppActionWithBody request with any body
+	CreateAppActionWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAppAction request
+	DeleteAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppAction request
+	GetAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAppActionWithBody request with any body
+	UpdateAppActionWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, body UpdateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateAppBundleWithBody request with any body
 	CreateAppBundleWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateAppBundle(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteAppBundle request
+	DeleteAppBundle(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppBundle request
+	GetAppBundle(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteAppEventSubscription request
 	DeleteAppEventSubscription(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2498,6 +3157,28 @@ type ClientInterface interface {
 
 	UpdateAppEventSubscription(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateAppKeyWithBody request with any body
+	CreateAppKeyWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAppKey request
+	DeleteAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppKey request
+	GetAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAppSigningSecret request
+	DeleteAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAppSigningSecret request
+	GetAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAppSigningSecretWithBody request with any body
+	UpdateAppSigningSecretWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UploadAppWithBody request with any body
 	UploadAppWithBody(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2543,15 +3224,15 @@ type ClientInterface interface {
 	GetAllEnvironmentAliases(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentAliasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteEnvironmentAlias request
-	DeleteEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetEnvironmentAlias request
-	GetEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpsertEnvironmentAliasWithBody request with any body
-	UpsertEnvironmentAliasWithBody(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpsertEnvironmentAliasWithBody(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpsertEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpsertEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAllEnvironments request
 	GetAllEnvironments(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -2703,6 +3384,61 @@ type ClientInterface interface {
 
 	UpdateLocale(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateReleaseWithBody request with any body
+	CreateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRelease request
+	DeleteRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRelease request
+	GetRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateReleaseWithBody request with any body
+	UpdateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReleaseAction request
+	GetReleaseAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId ReleaseActionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpublishRelease request
+	UnpublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UnpublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishRelease request
+	PublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateReleaseWithBody request with any body
+	ValidateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, body ValidateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUploadWithBody request with any body
+	CreateUploadWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUpload request
+	DeleteUpload(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUpload request
+	GetUpload(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWorkflowDefinitionWithBody request with any body
+	CreateWorkflowDefinitionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWorkflowDefinition request
+	DeleteWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *DeleteWorkflowDefinitionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkflowDefinition request
+	GetWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWorkflowDefinitionWithBody request with any body
+	UpdateWorkflowDefinitionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, body UpdateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAllPreviewApiKeys request
 	GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2848,6 +3584,78 @@ func (c *Client) UpdateAppDefinition(ctx context.Context, organizationId Organiz
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateAppActionWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAppActionRequestWithBody(c.Server, organizationId, resourceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAppActionRequest(c.Server, organizationId, resourceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppActionRequest(c.Server, organizationId, resourceId, appActionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppActionRequest(c.Server, organizationId, resourceId, appActionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppActionWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppActionRequestWithBody(c.Server, organizationId, resourceId, appActionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppAction(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, body UpdateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppActionRequest(c.Server, organizationId, resourceId, appActionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateAppBundleWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateAppBundleRequestWithBody(c.Server, organizationId, resourceId, contentType, body)
 	if err != nil {
@@ -2872,6 +3680,30 @@ func (c *Client) CreateAppBundle(ctx context.Context, organizationId Organizatio
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteAppBundle(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppBundleRequest(c.Server, organizationId, resourceId, appBundleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppBundle(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppBundleRequest(c.Server, organizationId, resourceId, appBundleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteAppEventSubscription(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteAppEventSubscriptionRequest(c.Server, organizationId, resourceId)
 	if err != nil {
@@ -2920,6 +3752,102 @@ func (c *Client) UpdateAppEventSubscription(ctx context.Context, organizationId
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateAppKeyWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAppKeyRequestWithBody(c.Server, organizationId, resourceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAppKeyRequest(c.Server, organizationId, resourceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppKeyRequest(c.Server, organizationId, resourceId, appKeyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppKey(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppKeyRequest(c.Server, organizationId, resourceId, appKeyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppSigningSecretRequest(c.Server, organizationId, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppSigningSecretRequest(c.Server, organizationId, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppSigningSecretWithBody(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppSigningSecretRequestWithBody(c.Server, organizationId, resourceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppSigningSecret(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppSigningSecretRequest(c.Server, organizationId, resourceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UploadAppWithBody(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUploadAppRequestWithBody(c.Server, organizationId, contentType, body)
 	if err != nil {
@@ -3112,7 +4040,7 @@ func (c *Client) GetAllEnvironmentAliases(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) DeleteEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteEnvironmentAliasRequest(c.Server, spaceId, aliasId, params)
 	if err != nil {
 		return nil, err
@@ -3124,7 +4052,7 @@ func (c *Client) DeleteEnvironmentAlias(ctx context.Context, spaceId SpaceId, al
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) GetEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnvironmentAliasRequest(c.Server, spaceId, aliasId)
 	if err != nil {
 		return nil, err
@@ -3136,7 +4064,7 @@ func (c *Client) GetEnvironmentAlias(ctx context.Context, spaceId SpaceId, alias
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpsertEnvironmentAliasWithBody(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) UpsertEnvironmentAliasWithBody(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpsertEnvironmentAliasRequestWithBody(c.Server, spaceId, aliasId, params, contentType, body)
 	if err != nil {
 		return nil, err
@@ -3148,7 +4076,7 @@ func (c *Client) UpsertEnvironmentAliasWithBody(ctx context.Context, spaceId Spa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpsertEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+func (c *Client) UpsertEnvironmentAlias(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpsertEnvironmentAliasRequest(c.Server, spaceId, aliasId, params, body)
 	if err != nil {
 		return nil, err
@@ -3808,8 +4736,8 @@ func (c *Client) UpdateLocale(ctx context.Context, spaceId SpaceId, environmentI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllPreviewApiKeysRequest(c.Server, spaceId, params)
+func (c *Client) CreateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReleaseRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3820,8 +4748,8 @@ func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPreviewApiKeyRequest(c.Server, spaceId, resourceId)
+func (c *Client) CreateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReleaseRequest(c.Server, spaceId, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3832,8 +4760,8 @@ func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resource
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePreviewEnvironmentRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) DeleteRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteReleaseRequest(c.Server, spaceId, environmentId, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3844,8 +4772,8 @@ func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId S
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePreviewEnvironmentRequest(c.Server, spaceId, body)
+func (c *Client) GetRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReleaseRequest(c.Server, spaceId, environmentId, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3856,8 +4784,8 @@ func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params)
+func (c *Client) UpdateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseRequestWithBody(c.Server, spaceId, environmentId, releaseId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3868,8 +4796,8 @@ func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPreviewEnvironmentRequest(c.Server, spaceId, resourceId)
+func (c *Client) UpdateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseRequest(c.Server, spaceId, environmentId, releaseId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3880,8 +4808,8 @@ func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, res
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePreviewEnvironmentRequestWithBody(c.Server, spaceId, resourceId, params, contentType, body)
+func (c *Client) GetReleaseAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId ReleaseActionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReleaseActionRequest(c.Server, spaceId, environmentId, releaseId, releaseActionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3892,8 +4820,8 @@ func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId S
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params, body)
+func (c *Client) UnpublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UnpublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpublishReleaseRequest(c.Server, spaceId, environmentId, releaseId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3904,8 +4832,8 @@ func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllRolesRequest(c.Server, spaceId, params)
+func (c *Client) PublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishReleaseRequest(c.Server, spaceId, environmentId, releaseId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3916,8 +4844,8 @@ func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRoleRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) ValidateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateReleaseRequestWithBody(c.Server, spaceId, environmentId, releaseId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3928,8 +4856,8 @@ func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, conten
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRoleRequest(c.Server, spaceId, body)
+func (c *Client) ValidateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, body ValidateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateReleaseRequest(c.Server, spaceId, environmentId, releaseId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3940,8 +4868,8 @@ func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRol
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteRoleRequest(c.Server, spaceId, roleId, params)
+func (c *Client) CreateUploadWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUploadRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3952,8 +4880,8 @@ func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRoleRequest(c.Server, spaceId, roleId)
+func (c *Client) DeleteUpload(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUploadRequest(c.Server, spaceId, environmentId, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -3964,8 +4892,8 @@ func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRoleRequestWithBody(c.Server, spaceId, roleId, params, contentType, body)
+func (c *Client) GetUpload(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadRequest(c.Server, spaceId, environmentId, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -3976,8 +4904,8 @@ func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRoleRequest(c.Server, spaceId, roleId, params, body)
+func (c *Client) CreateWorkflowDefinitionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkflowDefinitionRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3988,8 +4916,8 @@ func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllWebhooksRequest(c.Server, spaceId, params)
+func (c *Client) CreateWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkflowDefinitionRequest(c.Server, spaceId, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4000,8 +4928,8 @@ func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *Ge
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateWebhookRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) DeleteWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *DeleteWorkflowDefinitionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWorkflowDefinitionRequest(c.Server, spaceId, environmentId, workflowDefinitionId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -4012,8 +4940,8 @@ func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateWebhookRequest(c.Server, spaceId, body)
+func (c *Client) GetWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkflowDefinitionRequest(c.Server, spaceId, environmentId, workflowDefinitionId)
 	if err != nil {
 		return nil, err
 	}
@@ -4024,8 +4952,8 @@ func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body Create
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWebhookRequest(c.Server, spaceId, webhookId, params)
+func (c *Client) UpdateWorkflowDefinitionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkflowDefinitionRequestWithBody(c.Server, spaceId, environmentId, workflowDefinitionId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4036,8 +4964,8 @@ func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId W
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWebhookRequest(c.Server, spaceId, webhookId)
+func (c *Client) UpdateWorkflowDefinition(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, body UpdateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkflowDefinitionRequest(c.Server, spaceId, environmentId, workflowDefinitionId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -4048,8 +4976,8 @@ func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId Webh
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWebhookRequestWithBody(c.Server, spaceId, webhookId, params, contentType, body)
+func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllPreviewApiKeysRequest(c.Server, spaceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -4060,8 +4988,8 @@ func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, web
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWebhookRequest(c.Server, spaceId, webhookId, params, body)
+func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreviewApiKeyRequest(c.Server, spaceId, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4072,10 +5000,250 @@ func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId W
 	return c.Client.Do(req)
 }
 
-// NewGetAllAppDefinitionsRequest generates requests for GetAllAppDefinitions
-func NewGetAllAppDefinitionsRequest(server string, organizationId OrganizationId, params *GetAllAppDefinitionsParams) (*http.Request, error) {
-	var err error
-
+func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePreviewEnvironmentRequestWithBody(c.Server, spaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePreviewEnvironmentRequest(c.Server, spaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreviewEnvironmentRequest(c.Server, spaceId, resourceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreviewEnvironmentRequestWithBody(c.Server, spaceId, resourceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllRolesRequest(c.Server, spaceId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequestWithBody(c.Server, spaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequest(c.Server, spaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleRequest(c.Server, spaceId, roleId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleRequest(c.Server, spaceId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequestWithBody(c.Server, spaceId, roleId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequest(c.Server, spaceId, roleId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllWebhooksRequest(c.Server, spaceId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, spaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, spaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, spaceId, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookRequest(c.Server, spaceId, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, spaceId, webhookId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, spaceId, webhookId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAllAppDefinitionsRequest generates requests for GetAllAppDefinitions
+func NewGetAllAppDefinitionsRequest(server string, organizationId OrganizationId, params *GetAllAppDefinitionsParams) (*http.Request, error) {
+	var err error
+
 	var pathParam0 string
 
 	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
@@ -4353,19 +5521,19 @@ func NewUpdateAppDefinitionRequestWithBody(server string, organizationId Organiz
 	return req, nil
 }
 
-// NewCreateAppBundleRequest calls the generic CreateAppBundle builder with application/json body
-func NewCreateAppBundleRequest(server string, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody) (*http.Request, error) {
+// NewCreateAppActionRequest calls the generic CreateAppAction builder with application/json body
+func NewCreateAppActionRequest(server string, organizationId OrganizationId, resourceId ResourceId, body CreateAppActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateAppBundleRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
+	return NewCreateAppActionRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
 }
 
-// NewCreateAppBundleRequestWithBody generates requests for CreateAppBundle with any type of body
-func NewCreateAppBundleRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAppActionRequestWithBody generates requests for CreateAppAction with any type of body
+func NewCreateAppActionRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4387,7 +5555,7 @@ func NewCreateAppBundleRequestWithBody(server string, organizationId Organizatio
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/app_bundles", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4407,8 +5575,8 @@ func NewCreateAppBundleRequestWithBody(server string, organizationId Organizatio
 	return req, nil
 }
 
-// NewDeleteAppEventSubscriptionRequest generates requests for DeleteAppEventSubscription
-func NewDeleteAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
+// NewDeleteAppActionRequest generates requests for DeleteAppAction
+func NewDeleteAppActionRequest(server string, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4425,12 +5593,19 @@ func NewDeleteAppEventSubscriptionRequest(server string, organizationId Organiza
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appActionId", runtime.ParamLocationPath, appActionId)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4448,8 +5623,8 @@ func NewDeleteAppEventSubscriptionRequest(server string, organizationId Organiza
 	return req, nil
 }
 
-// NewGetAppEventSubscriptionRequest generates requests for GetAppEventSubscription
-func NewGetAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
+// NewGetAppActionRequest generates requests for GetAppAction
+func NewGetAppActionRequest(server string, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4466,12 +5641,19 @@ func NewGetAppEventSubscriptionRequest(server string, organizationId Organizatio
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appActionId", runtime.ParamLocationPath, appActionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4489,19 +5671,19 @@ func NewGetAppEventSubscriptionRequest(server string, organizationId Organizatio
 	return req, nil
 }
 
-// NewUpdateAppEventSubscriptionRequest calls the generic UpdateAppEventSubscription builder with application/json body
-func NewUpdateAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody) (*http.Request, error) {
+// NewUpdateAppActionRequest calls the generic UpdateAppAction builder with application/json body
+func NewUpdateAppActionRequest(server string, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, body UpdateAppActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAppEventSubscriptionRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
+	return NewUpdateAppActionRequestWithBody(server, organizationId, resourceId, appActionId, "application/json", bodyReader)
 }
 
-// NewUpdateAppEventSubscriptionRequestWithBody generates requests for UpdateAppEventSubscription with any type of body
-func NewUpdateAppEventSubscriptionRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAppActionRequestWithBody generates requests for UpdateAppAction with any type of body
+func NewUpdateAppActionRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4518,12 +5700,19 @@ func NewUpdateAppEventSubscriptionRequestWithBody(server string, organizationId
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appActionId", runtime.ParamLocationPath, appActionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/actions/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4543,8 +5732,19 @@ func NewUpdateAppEventSubscriptionRequestWithBody(server string, organizationId
 	return req, nil
 }
 
-// NewUploadAppRequestWithBody generates requests for UploadApp with any type of body
-func NewUploadAppRequestWithBody(server string, organizationId OrganizationId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAppBundleRequest calls the generic CreateAppBundle builder with application/json body
+func NewCreateAppBundleRequest(server string, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAppBundleRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
+}
+
+// NewCreateAppBundleRequestWithBody generates requests for CreateAppBundle with any type of body
+func NewCreateAppBundleRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4554,12 +5754,19 @@ func NewUploadAppRequestWithBody(server string, organizationId OrganizationId, c
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/app_uploads", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/app_bundles", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4579,16 +5786,37 @@ func NewUploadAppRequestWithBody(server string, organizationId OrganizationId, c
 	return req, nil
 }
 
-// NewGetAllSpacesRequest generates requests for GetAllSpaces
-func NewGetAllSpacesRequest(server string, params *GetAllSpacesParams) (*http.Request, error) {
+// NewDeleteAppBundleRequest generates requests for DeleteAppBundle
+func NewDeleteAppBundleRequest(server string, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appBundleId", runtime.ParamLocationPath, appBundleId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces")
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/app_bundles/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4598,73 +5826,45 @@ func NewGetAllSpacesRequest(server string, params *GetAllSpacesParams) (*http.Re
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Skip != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewGetAppBundleRequest generates requests for GetAppBundle
+func NewGetAppBundleRequest(server string, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam2 string
 
-// NewCreateSpaceRequest calls the generic CreateSpace builder with application/json body
-func NewCreateSpaceRequest(server string, params *CreateSpaceParams, body CreateSpaceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appBundleId", runtime.ParamLocationPath, appBundleId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateSpaceRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewCreateSpaceRequestWithBody generates requests for CreateSpace with any type of body
-func NewCreateSpaceRequestWithBody(server string, params *CreateSpaceParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces")
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/app_bundles/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4674,36 +5874,28 @@ func NewCreateSpaceRequestWithBody(server string, params *CreateSpaceParams, con
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-Type", runtime.ParamLocationHeader, params.ContentType)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Content-Type", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewDeleteSpaceRequest generates requests for DeleteSpace
-func NewDeleteSpaceRequest(server string, spaceId SpaceId, params *DeleteSpaceParams) (*http.Request, error) {
+// NewDeleteAppEventSubscriptionRequest generates requests for DeleteAppEventSubscription
+func NewDeleteAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4713,7 +5905,7 @@ func NewDeleteSpaceRequest(server string, spaceId SpaceId, params *DeleteSpacePa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4728,29 +5920,23 @@ func NewDeleteSpaceRequest(server string, spaceId SpaceId, params *DeleteSpacePa
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewGetSpaceRequest generates requests for GetSpace
-func NewGetSpaceRequest(server string, spaceId SpaceId) (*http.Request, error) {
+// NewGetAppEventSubscriptionRequest generates requests for GetAppEventSubscription
+func NewGetAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4760,7 +5946,7 @@ func NewGetSpaceRequest(server string, spaceId SpaceId) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4778,24 +5964,31 @@ func NewGetSpaceRequest(server string, spaceId SpaceId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateSpaceRequest calls the generic UpdateSpace builder with application/json body
-func NewUpdateSpaceRequest(server string, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody) (*http.Request, error) {
+// NewUpdateAppEventSubscriptionRequest calls the generic UpdateAppEventSubscription builder with application/json body
+func NewUpdateAppEventSubscriptionRequest(server string, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSpaceRequestWithBody(server, spaceId, params, "application/json", bodyReader)
+	return NewUpdateAppEventSubscriptionRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
 }
 
-// NewUpdateSpaceRequestWithBody generates requests for UpdateSpace with any type of body
-func NewUpdateSpaceRequestWithBody(server string, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAppEventSubscriptionRequestWithBody generates requests for UpdateAppEventSubscription with any type of body
+func NewUpdateAppEventSubscriptionRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4805,7 +5998,7 @@ func NewUpdateSpaceRequestWithBody(server string, spaceId SpaceId, params *Updat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/event_subscription", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4822,29 +6015,34 @@ func NewUpdateSpaceRequestWithBody(server string, spaceId SpaceId, params *Updat
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
+	return req, nil
+}
 
+// NewCreateAppKeyRequest calls the generic CreateAppKey builder with application/json body
+func NewCreateAppKeyRequest(server string, organizationId OrganizationId, resourceId ResourceId, body CreateAppKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAppKeyRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
 }
 
-// NewGetAllApiKeysRequest generates requests for GetAllApiKeys
-func NewGetAllApiKeysRequest(server string, spaceId SpaceId, params *GetAllApiKeysParams) (*http.Request, error) {
+// NewCreateAppKeyRequestWithBody generates requests for CreateAppKey with any type of body
+func NewCreateAppKeyRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4854,7 +6052,7 @@ func NewGetAllApiKeysRequest(server string, spaceId SpaceId, params *GetAllApiKe
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/api_keys", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4864,70 +6062,85 @@ func NewGetAllApiKeysRequest(server string, spaceId SpaceId, params *GetAllApiKe
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Limit != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewDeleteAppKeyRequest generates requests for DeleteAppKey
+func NewDeleteAppKeyRequest(server string, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId) (*http.Request, error) {
+	var err error
 
-		if params.Skip != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appKeyId", runtime.ParamLocationPath, appKeyId)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
-func NewCreateApiKeyRequest(server string, spaceId SpaceId, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateApiKeyRequestWithBody(server, spaceId, "application/json", bodyReader)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
-func NewCreateApiKeyRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAppKeyRequest generates requests for GetAppKey
+func NewGetAppKeyRequest(server string, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "appKeyId", runtime.ParamLocationPath, appKeyId)
 	if err != nil {
 		return nil, err
 	}
@@ -4937,7 +6150,7 @@ func NewCreateApiKeyRequestWithBody(server string, spaceId SpaceId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/api_keys", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/keys/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4947,30 +6160,28 @@ func NewCreateApiKeyRequestWithBody(server string, spaceId SpaceId, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteApiKeyRequest generates requests for DeleteApiKey
-func NewDeleteApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams) (*http.Request, error) {
+// NewDeleteAppSigningSecretRequest generates requests for DeleteAppSigningSecret
+func NewDeleteAppSigningSecretRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -4980,7 +6191,7 @@ func NewDeleteApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4995,36 +6206,23 @@ func NewDeleteApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, p
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewGetApiKeyRequest generates requests for GetApiKey
-func NewGetApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId) (*http.Request, error) {
+// NewGetAppSigningSecretRequest generates requests for GetAppSigningSecret
+func NewGetAppSigningSecretRequest(server string, organizationId OrganizationId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -5034,7 +6232,7 @@ func NewGetApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5052,31 +6250,31 @@ func NewGetApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId) (*ht
 	return req, nil
 }
 
-// NewUpdateApiKeyRequest calls the generic UpdateApiKey builder with application/json body
-func NewUpdateApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody) (*http.Request, error) {
+// NewUpdateAppSigningSecretRequest calls the generic UpdateAppSigningSecret builder with application/json body
+func NewUpdateAppSigningSecretRequest(server string, organizationId OrganizationId, resourceId ResourceId, body UpdateAppSigningSecretJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateApiKeyRequestWithBody(server, spaceId, apiKeyId, params, "application/json", bodyReader)
+	return NewUpdateAppSigningSecretRequestWithBody(server, organizationId, resourceId, "application/json", bodyReader)
 }
 
-// NewUpdateApiKeyRequestWithBody generates requests for UpdateApiKey with any type of body
-func NewUpdateApiKeyRequestWithBody(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAppSigningSecretRequestWithBody generates requests for UpdateAppSigningSecret with any type of body
+func NewUpdateAppSigningSecretRequestWithBody(server string, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
 	if err != nil {
 		return nil, err
 	}
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -5086,7 +6284,7 @@ func NewUpdateApiKeyRequestWithBody(server string, spaceId SpaceId, apiKeyId Api
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions/%s/signing_secret", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5103,39 +6301,55 @@ func NewUpdateApiKeyRequestWithBody(server string, spaceId SpaceId, apiKeyId Api
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params != nil {
-
-		var headerParam0 string
+	return req, nil
+}
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
+// NewUploadAppRequestWithBody generates requests for UploadApp with any type of body
+func NewUploadAppRequestWithBody(server string, organizationId OrganizationId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		req.Header.Set("X-Contentful-Version", headerParam0)
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
 	}
 
-	return req, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// NewGetAllEnvironmentAliasesRequest generates requests for GetAllEnvironmentAliases
-func NewGetAllEnvironmentAliasesRequest(server string, spaceId SpaceId, params *GetAllEnvironmentAliasesParams) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/organizations/%s/app_uploads", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllSpacesRequest generates requests for GetAllSpaces
+func NewGetAllSpacesRequest(server string, params *GetAllSpacesParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases", pathParam0)
+	operationPath := fmt.Sprintf("/spaces")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5191,20 +6405,66 @@ func NewGetAllEnvironmentAliasesRequest(server string, spaceId SpaceId, params *
 	return req, nil
 }
 
-// NewDeleteEnvironmentAliasRequest generates requests for DeleteEnvironmentAlias
-func NewDeleteEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId string, params *DeleteEnvironmentAliasParams) (*http.Request, error) {
+// NewCreateSpaceRequest calls the generic CreateSpace builder with application/json body
+func NewCreateSpaceRequest(server string, params *CreateSpaceParams, body CreateSpaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSpaceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateSpaceRequestWithBody generates requests for CreateSpace with any type of body
+func NewCreateSpaceRequestWithBody(server string, params *CreateSpaceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	operationPath := fmt.Sprintf("/spaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-Type", runtime.ParamLocationHeader, params.ContentType)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Content-Type", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSpaceRequest generates requests for DeleteSpace
+func NewDeleteSpaceRequest(server string, spaceId SpaceId, params *DeleteSpaceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
 	if err != nil {
 		return nil, err
 	}
@@ -5214,7 +6474,7 @@ func NewDeleteEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5245,8 +6505,8 @@ func NewDeleteEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId st
 	return req, nil
 }
 
-// NewGetEnvironmentAliasRequest generates requests for GetEnvironmentAlias
-func NewGetEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId string) (*http.Request, error) {
+// NewGetSpaceRequest generates requests for GetSpace
+func NewGetSpaceRequest(server string, spaceId SpaceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5256,19 +6516,12 @@ func NewGetEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId strin
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5286,19 +6539,19 @@ func NewGetEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId strin
 	return req, nil
 }
 
-// NewUpsertEnvironmentAliasRequest calls the generic UpsertEnvironmentAlias builder with application/json body
-func NewUpsertEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody) (*http.Request, error) {
+// NewUpdateSpaceRequest calls the generic UpdateSpace builder with application/json body
+func NewUpdateSpaceRequest(server string, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpsertEnvironmentAliasRequestWithBody(server, spaceId, aliasId, params, "application/json", bodyReader)
+	return NewUpdateSpaceRequestWithBody(server, spaceId, params, "application/json", bodyReader)
 }
 
-// NewUpsertEnvironmentAliasRequestWithBody generates requests for UpsertEnvironmentAlias with any type of body
-func NewUpsertEnvironmentAliasRequestWithBody(server string, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateSpaceRequestWithBody generates requests for UpdateSpace with any type of body
+func NewUpdateSpaceRequestWithBody(server string, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5308,19 +6561,12 @@ func NewUpsertEnvironmentAliasRequestWithBody(server string, spaceId SpaceId, al
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5353,8 +6599,8 @@ func NewUpsertEnvironmentAliasRequestWithBody(server string, spaceId SpaceId, al
 	return req, nil
 }
 
-// NewGetAllEnvironmentsRequest generates requests for GetAllEnvironments
-func NewGetAllEnvironmentsRequest(server string, spaceId SpaceId, params *GetAllEnvironmentsParams) (*http.Request, error) {
+// NewGetAllApiKeysRequest generates requests for GetAllApiKeys
+func NewGetAllApiKeysRequest(server string, spaceId SpaceId, params *GetAllApiKeysParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5369,7 +6615,7 @@ func NewGetAllEnvironmentsRequest(server string, spaceId SpaceId, params *GetAll
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/api_keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5425,19 +6671,19 @@ func NewGetAllEnvironmentsRequest(server string, spaceId SpaceId, params *GetAll
 	return req, nil
 }
 
-// NewCreateEnvironmentRequest calls the generic CreateEnvironment builder with application/json body
-func NewCreateEnvironmentRequest(server string, spaceId SpaceId, body CreateEnvironmentJSONRequestBody) (*http.Request, error) {
+// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
+func NewCreateApiKeyRequest(server string, spaceId SpaceId, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
+	return NewCreateApiKeyRequestWithBody(server, spaceId, "application/json", bodyReader)
 }
 
-// NewCreateEnvironmentRequestWithBody generates requests for CreateEnvironment with any type of body
-func NewCreateEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
+func NewCreateApiKeyRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5452,7 +6698,7 @@ func NewCreateEnvironmentRequestWithBody(server string, spaceId SpaceId, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/api_keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5472,8 +6718,8 @@ func NewCreateEnvironmentRequestWithBody(server string, spaceId SpaceId, content
 	return req, nil
 }
 
-// NewDeleteEnvironmentRequest generates requests for DeleteEnvironment
-func NewDeleteEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams) (*http.Request, error) {
+// NewDeleteApiKeyRequest generates requests for DeleteApiKey
+func NewDeleteApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5485,7 +6731,7 @@ func NewDeleteEnvironmentRequest(server string, spaceId SpaceId, environmentId E
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
 	if err != nil {
 		return nil, err
 	}
@@ -5495,7 +6741,7 @@ func NewDeleteEnvironmentRequest(server string, spaceId SpaceId, environmentId E
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5526,8 +6772,8 @@ func NewDeleteEnvironmentRequest(server string, spaceId SpaceId, environmentId E
 	return req, nil
 }
 
-// NewGetEnvironmentRequest generates requests for GetEnvironment
-func NewGetEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId) (*http.Request, error) {
+// NewGetApiKeyRequest generates requests for GetApiKey
+func NewGetApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5539,7 +6785,7 @@ func NewGetEnvironmentRequest(server string, spaceId SpaceId, environmentId Envi
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
 	if err != nil {
 		return nil, err
 	}
@@ -5549,7 +6795,7 @@ func NewGetEnvironmentRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5567,19 +6813,19 @@ func NewGetEnvironmentRequest(server string, spaceId SpaceId, environmentId Envi
 	return req, nil
 }
 
-// NewUpdateEnvironmentRequest calls the generic UpdateEnvironment builder with application/json body
-func NewUpdateEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody) (*http.Request, error) {
+// NewUpdateApiKeyRequest calls the generic UpdateApiKey builder with application/json body
+func NewUpdateApiKeyRequest(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEnvironmentRequestWithBody(server, spaceId, environmentId, params, "application/json", bodyReader)
+	return NewUpdateApiKeyRequestWithBody(server, spaceId, apiKeyId, params, "application/json", bodyReader)
 }
 
-// NewUpdateEnvironmentRequestWithBody generates requests for UpdateEnvironment with any type of body
-func NewUpdateEnvironmentRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateApiKeyRequestWithBody generates requests for UpdateApiKey with any type of body
+func NewUpdateApiKeyRequestWithBody(server string, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5591,7 +6837,7 @@ func NewUpdateEnvironmentRequestWithBody(server string, spaceId SpaceId, environ
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apiKeyId", runtime.ParamLocationPath, apiKeyId)
 	if err != nil {
 		return nil, err
 	}
@@ -5601,7 +6847,7 @@ func NewUpdateEnvironmentRequestWithBody(server string, spaceId SpaceId, environ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/api_keys/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5634,8 +6880,8 @@ func NewUpdateEnvironmentRequestWithBody(server string, spaceId SpaceId, environ
 	return req, nil
 }
 
-// NewGetAllAppInstallationsRequest generates requests for GetAllAppInstallations
-func NewGetAllAppInstallationsRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams) (*http.Request, error) {
+// NewGetAllEnvironmentAliasesRequest generates requests for GetAllEnvironmentAliases
+func NewGetAllEnvironmentAliasesRequest(server string, spaceId SpaceId, params *GetAllEnvironmentAliasesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5645,19 +6891,12 @@ func NewGetAllAppInstallationsRequest(server string, spaceId SpaceId, environmen
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5713,8 +6952,8 @@ func NewGetAllAppInstallationsRequest(server string, spaceId SpaceId, environmen
 	return req, nil
 }
 
-// NewDeleteAppInstallationRequest generates requests for DeleteAppInstallation
-func NewDeleteAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams) (*http.Request, error) {
+// NewDeleteEnvironmentAliasRequest generates requests for DeleteEnvironmentAlias
+func NewDeleteEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId AliasId, params *DeleteEnvironmentAliasParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5726,14 +6965,7 @@ func NewDeleteAppInstallationRequest(server string, spaceId SpaceId, environment
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
 	if err != nil {
 		return nil, err
 	}
@@ -5743,7 +6975,7 @@ func NewDeleteAppInstallationRequest(server string, spaceId SpaceId, environment
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5774,8 +7006,8 @@ func NewDeleteAppInstallationRequest(server string, spaceId SpaceId, environment
 	return req, nil
 }
 
-// NewGetAppInstallationRequest generates requests for GetAppInstallation
-func NewGetAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
+// NewGetEnvironmentAliasRequest generates requests for GetEnvironmentAlias
+func NewGetEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId AliasId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5787,14 +7019,7 @@ func NewGetAppInstallationRequest(server string, spaceId SpaceId, environmentId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
 	if err != nil {
 		return nil, err
 	}
@@ -5804,7 +7029,7 @@ func NewGetAppInstallationRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5822,19 +7047,19 @@ func NewGetAppInstallationRequest(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewUpsertAppInstallationRequest calls the generic UpsertAppInstallation builder with application/json body
-func NewUpsertAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody) (*http.Request, error) {
+// NewUpsertEnvironmentAliasRequest calls the generic UpsertEnvironmentAlias builder with application/json body
+func NewUpsertEnvironmentAliasRequest(server string, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpsertAppInstallationRequestWithBody(server, spaceId, environmentId, resourceId, params, "application/json", bodyReader)
+	return NewUpsertEnvironmentAliasRequestWithBody(server, spaceId, aliasId, params, "application/json", bodyReader)
 }
 
-// NewUpsertAppInstallationRequestWithBody generates requests for UpsertAppInstallation with any type of body
-func NewUpsertAppInstallationRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpsertEnvironmentAliasRequestWithBody generates requests for UpsertEnvironmentAlias with any type of body
+func NewUpsertEnvironmentAliasRequestWithBody(server string, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5846,14 +7071,7 @@ func NewUpsertAppInstallationRequestWithBody(server string, spaceId SpaceId, env
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "aliasId", runtime.ParamLocationPath, aliasId)
 	if err != nil {
 		return nil, err
 	}
@@ -5863,7 +7081,7 @@ func NewUpsertAppInstallationRequestWithBody(server string, spaceId SpaceId, env
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environment_aliases/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5882,24 +7100,22 @@ func NewUpsertAppInstallationRequestWithBody(server string, spaceId SpaceId, env
 
 	if params != nil {
 
-		if params.XContentfulMarketplace != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Marketplace", runtime.ParamLocationHeader, *params.XContentfulMarketplace)
-			if err != nil {
-				return nil, err
-			}
+		var headerParam0 string
 
-			req.Header.Set("X-Contentful-Marketplace", headerParam0)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
 		}
 
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
 	}
 
 	return req, nil
 }
 
-// NewGetAllAssetsRequest generates requests for GetAllAssets
-func NewGetAllAssetsRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams) (*http.Request, error) {
+// NewGetAllEnvironmentsRequest generates requests for GetAllEnvironments
+func NewGetAllEnvironmentsRequest(server string, spaceId SpaceId, params *GetAllEnvironmentsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5909,19 +7125,12 @@ func NewGetAllAssetsRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5977,19 +7186,19 @@ func NewGetAllAssetsRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewCreateAssetRequest calls the generic CreateAsset builder with application/json body
-func NewCreateAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody) (*http.Request, error) {
+// NewCreateEnvironmentRequest calls the generic CreateEnvironment builder with application/json body
+func NewCreateEnvironmentRequest(server string, spaceId SpaceId, body CreateEnvironmentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateAssetRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+	return NewCreateEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
 }
 
-// NewCreateAssetRequestWithBody generates requests for CreateAsset with any type of body
-func NewCreateAssetRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateEnvironmentRequestWithBody generates requests for CreateEnvironment with any type of body
+func NewCreateEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5999,19 +7208,12 @@ func NewCreateAssetRequestWithBody(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6031,8 +7233,8 @@ func NewCreateAssetRequestWithBody(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewDeleteAssetRequest generates requests for DeleteAsset
-func NewDeleteAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams) (*http.Request, error) {
+// NewDeleteEnvironmentRequest generates requests for DeleteEnvironment
+func NewDeleteEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6049,19 +7251,12 @@ func NewDeleteAssetRequest(server string, spaceId SpaceId, environmentId Environ
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6092,8 +7287,8 @@ func NewDeleteAssetRequest(server string, spaceId SpaceId, environmentId Environ
 	return req, nil
 }
 
-// NewGetAssetRequest generates requests for GetAsset
-func NewGetAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
+// NewGetEnvironmentRequest generates requests for GetEnvironment
+func NewGetEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6110,19 +7305,12 @@ func NewGetAssetRequest(server string, spaceId SpaceId, environmentId Environmen
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6140,19 +7328,19 @@ func NewGetAssetRequest(server string, spaceId SpaceId, environmentId Environmen
 	return req, nil
 }
 
-// NewUpdateAssetRequest calls the generic UpdateAsset builder with application/json body
-func NewUpdateAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody) (*http.Request, error) {
+// NewUpdateEnvironmentRequest calls the generic UpdateEnvironment builder with application/json body
+func NewUpdateEnvironmentRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAssetRequestWithBody(server, spaceId, environmentId, resourceId, params, "application/json", bodyReader)
+	return NewUpdateEnvironmentRequestWithBody(server, spaceId, environmentId, params, "application/json", bodyReader)
 }
 
-// NewUpdateAssetRequestWithBody generates requests for UpdateAsset with any type of body
-func NewUpdateAssetRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateEnvironmentRequestWithBody generates requests for UpdateEnvironment with any type of body
+func NewUpdateEnvironmentRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6169,19 +7357,12 @@ func NewUpdateAssetRequestWithBody(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6214,8 +7395,8 @@ func NewUpdateAssetRequestWithBody(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewUnarchiveAssetRequest generates requests for UnarchiveAsset
-func NewUnarchiveAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams) (*http.Request, error) {
+// NewGetAllAppInstallationsRequest generates requests for GetAllAppInstallations
+func NewGetAllAppInstallationsRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6232,19 +7413,12 @@ func NewUnarchiveAssetRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6254,29 +7428,54 @@ func NewUnarchiveAssetRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
 	if params != nil {
+		queryValues := queryURL.Query()
 
-		var headerParam0 string
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
 		}
 
-		req.Header.Set("X-Contentful-Version", headerParam0)
+		if params.Skip != nil {
 
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewArchiveAssetRequest generates requests for ArchiveAsset
-func NewArchiveAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams) (*http.Request, error) {
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAppInstallationRequest generates requests for DeleteAppInstallation
+func NewDeleteAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6305,7 +7504,7 @@ func NewArchiveAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6315,7 +7514,7 @@ func NewArchiveAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6336,8 +7535,8 @@ func NewArchiveAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewProcessAssetRequest generates requests for ProcessAsset
-func NewProcessAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode) (*http.Request, error) {
+// NewGetAppInstallationRequest generates requests for GetAppInstallation
+func NewGetAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6361,19 +7560,12 @@ func NewProcessAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "localeCode", runtime.ParamLocationPath, localeCode)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/files/%s/process", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6383,7 +7575,7 @@ func NewProcessAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6391,69 +7583,19 @@ func NewProcessAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewUnpublishAssetRequest generates requests for UnpublishAsset
-func NewUnpublishAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+// NewUpsertAppInstallationRequest calls the generic UpsertAppInstallation builder with application/json body
+func NewUpsertAppInstallationRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertAppInstallationRequestWithBody(server, spaceId, environmentId, resourceId, params, "application/json", bodyReader)
 }
 
-// NewPublishAssetRequest generates requests for PublishAsset
-func NewPublishAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams) (*http.Request, error) {
+// NewUpsertAppInstallationRequestWithBody generates requests for UpsertAppInstallation with any type of body
+func NewUpsertAppInstallationRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6482,7 +7624,7 @@ func NewPublishAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/app_installations/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6492,29 +7634,33 @@ func NewPublishAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
-		var headerParam0 string
+		if params.XContentfulMarketplace != nil {
+			var headerParam0 string
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Marketplace", runtime.ParamLocationHeader, *params.XContentfulMarketplace)
+			if err != nil {
+				return nil, err
+			}
 
-		req.Header.Set("X-Contentful-Version", headerParam0)
+			req.Header.Set("X-Contentful-Marketplace", headerParam0)
+		}
 
 	}
 
 	return req, nil
 }
 
-// NewGetAllContentTypesRequest generates requests for GetAllContentTypes
-func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams) (*http.Request, error) {
+// NewGetAllAssetsRequest generates requests for GetAllAssets
+func NewGetAllAssetsRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6536,7 +7682,7 @@ func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6592,19 +7738,19 @@ func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewCreateContentTypeRequest calls the generic CreateContentType builder with application/json body
-func NewCreateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody) (*http.Request, error) {
+// NewCreateAssetRequest calls the generic CreateAsset builder with application/json body
+func NewCreateAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateContentTypeRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+	return NewCreateAssetRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
 }
 
-// NewCreateContentTypeRequestWithBody generates requests for CreateContentType with any type of body
-func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAssetRequestWithBody generates requests for CreateAsset with any type of body
+func NewCreateAssetRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6626,7 +7772,7 @@ func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6646,8 +7792,8 @@ func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 	return req, nil
 }
 
-// NewDeleteContentTypeRequest generates requests for DeleteContentType
-func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams) (*http.Request, error) {
+// NewDeleteAssetRequest generates requests for DeleteAsset
+func NewDeleteAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6666,7 +7812,7 @@ func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId E
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -6676,7 +7822,7 @@ func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId E
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6707,8 +7853,8 @@ func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId E
 	return req, nil
 }
 
-// NewGetContentTypeRequest generates requests for GetContentType
-func NewGetContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId) (*http.Request, error) {
+// NewGetAssetRequest generates requests for GetAsset
+func NewGetAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6727,7 +7873,7 @@ func NewGetContentTypeRequest(server string, spaceId SpaceId, environmentId Envi
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -6737,7 +7883,7 @@ func NewGetContentTypeRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6755,19 +7901,19 @@ func NewGetContentTypeRequest(server string, spaceId SpaceId, environmentId Envi
 	return req, nil
 }
 
-// NewUpdateContentTypeRequest calls the generic UpdateContentType builder with application/json body
-func NewUpdateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody) (*http.Request, error) {
+// NewUpdateAssetRequest calls the generic UpdateAsset builder with application/json body
+func NewUpdateAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateContentTypeRequestWithBody(server, spaceId, environmentId, contentTypeId, params, "application/json", bodyReader)
+	return NewUpdateAssetRequestWithBody(server, spaceId, environmentId, resourceId, params, "application/json", bodyReader)
 }
 
-// NewUpdateContentTypeRequestWithBody generates requests for UpdateContentType with any type of body
-func NewUpdateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAssetRequestWithBody generates requests for UpdateAsset with any type of body
+func NewUpdateAssetRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6786,7 +7932,7 @@ func NewUpdateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -6796,7 +7942,7 @@ func NewUpdateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6829,8 +7975,8 @@ func NewUpdateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 	return req, nil
 }
 
-// NewGetEditorInterfaceRequest generates requests for GetEditorInterface
-func NewGetEditorInterfaceRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId) (*http.Request, error) {
+// NewUnarchiveAssetRequest generates requests for UnarchiveAsset
+func NewUnarchiveAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6849,7 +7995,7 @@ func NewGetEditorInterfaceRequest(server string, spaceId SpaceId, environmentId
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -6859,7 +8005,7 @@ func NewGetEditorInterfaceRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6869,27 +8015,29 @@ func NewGetEditorInterfaceRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
 
-// NewUpdateEditorInterfaceRequest calls the generic UpdateEditorInterface builder with application/json body
-func NewUpdateEditorInterfaceRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEditorInterfaceRequestWithBody(server, spaceId, environmentId, contentTypeId, params, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewUpdateEditorInterfaceRequestWithBody generates requests for UpdateEditorInterface with any type of body
-func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewArchiveAssetRequest generates requests for ArchiveAsset
+func NewArchiveAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6908,7 +8056,7 @@ func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, env
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -6918,7 +8066,7 @@ func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, env
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/archived", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6928,13 +8076,11 @@ func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, env
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	if params != nil {
 
 		var headerParam0 string
@@ -6951,8 +8097,8 @@ func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, env
 	return req, nil
 }
 
-// NewDeactivateContentTypeRequest generates requests for DeactivateContentType
-func NewDeactivateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams) (*http.Request, error) {
+// NewProcessAssetRequest generates requests for ProcessAsset
+func NewProcessAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6971,7 +8117,14 @@ func NewDeactivateContentTypeRequest(server string, spaceId SpaceId, environment
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "localeCode", runtime.ParamLocationPath, localeCode)
 	if err != nil {
 		return nil, err
 	}
@@ -6981,7 +8134,7 @@ func NewDeactivateContentTypeRequest(server string, spaceId SpaceId, environment
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/files/%s/process", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6991,29 +8144,16 @@ func NewDeactivateContentTypeRequest(server string, spaceId SpaceId, environment
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewActivateContentTypeRequest generates requests for ActivateContentType
-func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams) (*http.Request, error) {
+// NewUnpublishAssetRequest generates requests for UnpublishAsset
+func NewUnpublishAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7032,7 +8172,7 @@ func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -7042,7 +8182,7 @@ func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7052,7 +8192,7 @@ func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7073,9 +8213,9 @@ func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewGetAllEntriesRequest generates requests for GetAllEntries
-func NewGetAllEntriesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams) (*http.Request, error) {
-	var err error
+// NewPublishAssetRequest generates requests for PublishAsset
+func NewPublishAssetRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams) (*http.Request, error) {
+	var err error
 
 	var pathParam0 string
 
@@ -7091,12 +8231,73 @@ func NewGetAllEntriesRequest(server string, spaceId SpaceId, environmentId Envir
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/assets/%s/published", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAllContentTypesRequest generates requests for GetAllContentTypes
+func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7141,22 +8342,6 @@ func NewGetAllEntriesRequest(server string, spaceId SpaceId, environmentId Envir
 
 		}
 
-		if params.ContentType != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -7168,19 +8353,19 @@ func NewGetAllEntriesRequest(server string, spaceId SpaceId, environmentId Envir
 	return req, nil
 }
 
-// NewCreateEntryRequest calls the generic CreateEntry builder with application/json body
-func NewCreateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody) (*http.Request, error) {
+// NewCreateContentTypeRequest calls the generic CreateContentType builder with application/json body
+func NewCreateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateEntryRequestWithBody(server, spaceId, environmentId, params, "application/json", bodyReader)
+	return NewCreateContentTypeRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
 }
 
-// NewCreateEntryRequestWithBody generates requests for CreateEntry with any type of body
-func NewCreateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateContentTypeRequestWithBody generates requests for CreateContentType with any type of body
+func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7202,7 +8387,7 @@ func NewCreateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7219,24 +8404,11 @@ func NewCreateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Content-Type", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewDeleteEntryRequest generates requests for DeleteEntry
-func NewDeleteEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams) (*http.Request, error) {
+// NewDeleteContentTypeRequest generates requests for DeleteContentType
+func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7255,7 +8427,7 @@ func NewDeleteEntryRequest(server string, spaceId SpaceId, environmentId Environ
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7265,7 +8437,7 @@ func NewDeleteEntryRequest(server string, spaceId SpaceId, environmentId Environ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7296,8 +8468,8 @@ func NewDeleteEntryRequest(server string, spaceId SpaceId, environmentId Environ
 	return req, nil
 }
 
-// NewGetEntryRequest generates requests for GetEntry
-func NewGetEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId) (*http.Request, error) {
+// NewGetContentTypeRequest generates requests for GetContentType
+func NewGetContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7316,7 +8488,7 @@ func NewGetEntryRequest(server string, spaceId SpaceId, environmentId Environmen
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7326,7 +8498,7 @@ func NewGetEntryRequest(server string, spaceId SpaceId, environmentId Environmen
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7344,19 +8516,19 @@ func NewGetEntryRequest(server string, spaceId SpaceId, environmentId Environmen
 	return req, nil
 }
 
-// NewUpdateEntryRequest calls the generic UpdateEntry builder with application/json body
-func NewUpdateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody) (*http.Request, error) {
+// NewUpdateContentTypeRequest calls the generic UpdateContentType builder with application/json body
+func NewUpdateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json", bodyReader)
+	return NewUpdateContentTypeRequestWithBody(server, spaceId, environmentId, contentTypeId, params, "application/json", bodyReader)
 }
 
-// NewUpdateEntryRequestWithBody generates requests for UpdateEntry with any type of body
-func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateContentTypeRequestWithBody generates requests for UpdateContentType with any type of body
+func NewUpdateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7375,7 +8547,7 @@ func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7385,7 +8557,7 @@ func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7413,22 +8585,13 @@ func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 
 		req.Header.Set("X-Contentful-Version", headerParam0)
 
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Content-Type", headerParam1)
-
 	}
 
 	return req, nil
 }
 
-// NewUnarchiveEntryRequest generates requests for UnarchiveEntry
-func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams) (*http.Request, error) {
+// NewGetEditorInterfaceRequest generates requests for GetEditorInterface
+func NewGetEditorInterfaceRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7447,7 +8610,7 @@ func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId Envi
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7457,7 +8620,7 @@ func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7467,29 +8630,27 @@ func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
+	return req, nil
+}
 
+// NewUpdateEditorInterfaceRequest calls the generic UpdateEditorInterface builder with application/json body
+func NewUpdateEditorInterfaceRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEditorInterfaceRequestWithBody(server, spaceId, environmentId, contentTypeId, params, "application/json", bodyReader)
 }
 
-// NewArchiveEntryRequest generates requests for ArchiveEntry
-func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams) (*http.Request, error) {
+// NewUpdateEditorInterfaceRequestWithBody generates requests for UpdateEditorInterface with any type of body
+func NewUpdateEditorInterfaceRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7508,7 +8669,7 @@ func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7518,7 +8679,7 @@ func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/editor_interface", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7528,11 +8689,13 @@ func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		var headerParam0 string
@@ -7549,8 +8712,8 @@ func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewUnpublishEntryRequest generates requests for UnpublishEntry
-func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams) (*http.Request, error) {
+// NewDeactivateContentTypeRequest generates requests for DeactivateContentType
+func NewDeactivateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7569,7 +8732,7 @@ func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId Envi
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7579,7 +8742,7 @@ func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7610,8 +8773,8 @@ func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId Envi
 	return req, nil
 }
 
-// NewPublishEntryRequest generates requests for PublishEntry
-func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams) (*http.Request, error) {
+// NewActivateContentTypeRequest generates requests for ActivateContentType
+func NewActivateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7630,7 +8793,7 @@ func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -7640,7 +8803,7 @@ func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7671,8 +8834,8 @@ func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewGetAllLocalesRequest generates requests for GetAllLocales
-func NewGetAllLocalesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams) (*http.Request, error) {
+// NewGetAllEntriesRequest generates requests for GetAllEntries
+func NewGetAllEntriesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7694,7 +8857,7 @@ func NewGetAllLocalesRequest(server string, spaceId SpaceId, environmentId Envir
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7739,6 +8902,22 @@ func NewGetAllLocalesRequest(server string, spaceId SpaceId, environmentId Envir
 
 		}
 
+		if params.ContentType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "content_type", runtime.ParamLocationQuery, *params.ContentType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -7750,19 +8929,19 @@ func NewGetAllLocalesRequest(server string, spaceId SpaceId, environmentId Envir
 	return req, nil
 }
 
-// NewCreateLocaleRequest calls the generic CreateLocale builder with application/json body
-func NewCreateLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody) (*http.Request, error) {
+// NewCreateEntryRequest calls the generic CreateEntry builder with application/json body
+func NewCreateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateLocaleRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+	return NewCreateEntryRequestWithBody(server, spaceId, environmentId, params, "application/json", bodyReader)
 }
 
-// NewCreateLocaleRequestWithBody generates requests for CreateLocale with any type of body
-func NewCreateLocaleRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateEntryRequestWithBody generates requests for CreateEntry with any type of body
+func NewCreateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7784,7 +8963,7 @@ func NewCreateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7801,19 +8980,32 @@ func NewCreateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 
 	req.Header.Add("Content-Type", contentType)
 
-	return req, nil
-}
+	if params != nil {
 
-// NewDeleteLocaleRequest generates requests for DeleteLocale
-func NewDeleteLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId) (*http.Request, error) {
-	var err error
+		var headerParam0 string
 
-	var pathParam0 string
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
+		if err != nil {
+			return nil, err
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
-	if err != nil {
-		return nil, err
-	}
+		req.Header.Set("X-Contentful-Content-Type", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteEntryRequest generates requests for DeleteEntry
+func NewDeleteEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
 	var pathParam1 string
 
@@ -7824,7 +9016,7 @@ func NewDeleteLocaleRequest(server string, spaceId SpaceId, environmentId Enviro
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -7834,7 +9026,7 @@ func NewDeleteLocaleRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7849,11 +9041,24 @@ func NewDeleteLocaleRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
 	return req, nil
 }
 
-// NewGetLocaleRequest generates requests for GetLocale
-func NewGetLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId) (*http.Request, error) {
+// NewGetEntryRequest generates requests for GetEntry
+func NewGetEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7872,7 +9077,7 @@ func NewGetLocaleRequest(server string, spaceId SpaceId, environmentId Environme
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -7882,7 +9087,7 @@ func NewGetLocaleRequest(server string, spaceId SpaceId, environmentId Environme
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7900,19 +9105,19 @@ func NewGetLocaleRequest(server string, spaceId SpaceId, environmentId Environme
 	return req, nil
 }
 
-// NewUpdateLocaleRequest calls the generic UpdateLocale builder with application/json body
-func NewUpdateLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody) (*http.Request, error) {
+// NewUpdateEntryRequest calls the generic UpdateEntry builder with application/json body
+func NewUpdateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateLocaleRequestWithBody(server, spaceId, environmentId, localeId, params, "application/json", bodyReader)
+	return NewUpdateEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json", bodyReader)
 }
 
-// NewUpdateLocaleRequestWithBody generates requests for UpdateLocale with any type of body
-func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateEntryRequestWithBody generates requests for UpdateEntry with any type of body
+func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7931,7 +9136,7 @@ func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 
 	var pathParam2 string
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -7941,7 +9146,7 @@ func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7969,13 +9174,22 @@ func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 
 		req.Header.Set("X-Contentful-Version", headerParam0)
 
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Content-Type", headerParam1)
+
 	}
 
 	return req, nil
 }
 
-// NewGetAllPreviewApiKeysRequest generates requests for GetAllPreviewApiKeys
-func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetAllPreviewApiKeysParams) (*http.Request, error) {
+// NewUnarchiveEntryRequest generates requests for UnarchiveEntry
+func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7985,12 +9199,26 @@ func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetA
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8000,54 +9228,29 @@ func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetA
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Skip != nil {
+	if params != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+		var headerParam0 string
 
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		req.Header.Set("X-Contentful-Version", headerParam0)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
 	return req, nil
 }
 
-// NewGetPreviewApiKeyRequest generates requests for GetPreviewApiKey
-func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+// NewArchiveEntryRequest generates requests for ArchiveEntry
+func NewArchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8059,52 +9262,14 @@ func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId Resou
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCreatePreviewEnvironmentRequest calls the generic CreatePreviewEnvironment builder with application/json body
-func NewCreatePreviewEnvironmentRequest(server string, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePreviewEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
-}
-
-// NewCreatePreviewEnvironmentRequestWithBody generates requests for CreatePreviewEnvironment with any type of body
-func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam2 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -8114,7 +9279,7 @@ func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8124,18 +9289,29 @@ func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
 
 	return req, nil
 }
 
-// NewDeletePreviewEnvironmentRequest generates requests for DeletePreviewEnvironment
-func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams) (*http.Request, error) {
+// NewUnpublishEntryRequest generates requests for UnpublishEntry
+func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8147,7 +9323,14 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -8157,7 +9340,7 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8188,8 +9371,8 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 	return req, nil
 }
 
-// NewGetPreviewEnvironmentRequest generates requests for GetPreviewEnvironment
-func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+// NewPublishEntryRequest generates requests for PublishEntry
+func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8201,7 +9384,14 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
 	if err != nil {
 		return nil, err
 	}
@@ -8211,7 +9401,7 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8221,27 +9411,29 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
 
-// NewUpdatePreviewEnvironmentRequest calls the generic UpdatePreviewEnvironment builder with application/json body
-func NewUpdatePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePreviewEnvironmentRequestWithBody(server, spaceId, resourceId, params, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewUpdatePreviewEnvironmentRequestWithBody generates requests for UpdatePreviewEnvironment with any type of body
-func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAllLocalesRequest generates requests for GetAllLocales
+func NewGetAllLocalesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8253,7 +9445,7 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
@@ -8263,56 +9455,7 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
-	return req, nil
-}
-
-// NewGetAllRolesRequest generates requests for GetAllRoles
-func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8368,19 +9511,19 @@ func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesPa
 	return req, nil
 }
 
-// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
-func NewCreateRoleRequest(server string, spaceId SpaceId, body CreateRoleJSONRequestBody) (*http.Request, error) {
+// NewCreateLocaleRequest calls the generic CreateLocale builder with application/json body
+func NewCreateLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateRoleRequestWithBody(server, spaceId, "application/json", bodyReader)
+	return NewCreateLocaleRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
 }
 
-// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
-func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateLocaleRequestWithBody generates requests for CreateLocale with any type of body
+func NewCreateLocaleRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8390,12 +9533,19 @@ func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType st
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8415,8 +9565,8 @@ func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType st
 	return req, nil
 }
 
-// NewDeleteRoleRequest generates requests for DeleteRole
-func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams) (*http.Request, error) {
+// NewDeleteLocaleRequest generates requests for DeleteLocale
+func NewDeleteLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8428,7 +9578,14 @@ func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
 	if err != nil {
 		return nil, err
 	}
@@ -8438,7 +9595,7 @@ func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8453,24 +9610,11 @@ func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewGetRoleRequest generates requests for GetRole
-func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Request, error) {
+// NewGetLocaleRequest generates requests for GetLocale
+func NewGetLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8482,7 +9626,14 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
 	if err != nil {
 		return nil, err
 	}
@@ -8492,7 +9643,7 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8510,19 +9661,19 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 	return req, nil
 }
 
-// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
-func NewUpdateRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+// NewUpdateLocaleRequest calls the generic UpdateLocale builder with application/json body
+func NewUpdateLocaleRequest(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateRoleRequestWithBody(server, spaceId, roleId, params, "application/json", bodyReader)
+	return NewUpdateLocaleRequestWithBody(server, spaceId, environmentId, localeId, params, "application/json", bodyReader)
 }
 
-// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
-func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateLocaleRequestWithBody generates requests for UpdateLocale with any type of body
+func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8534,7 +9685,14 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "localeId", runtime.ParamLocationPath, localeId)
 	if err != nil {
 		return nil, err
 	}
@@ -8544,7 +9702,7 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/locales/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8577,91 +9735,19 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 	return req, nil
 }
 
-// NewGetAllWebhooksRequest generates requests for GetAllWebhooks
-func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebhooksParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Skip != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
-func NewCreateWebhookRequest(server string, spaceId SpaceId, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+// NewCreateReleaseRequest calls the generic CreateRelease builder with application/json body
+func NewCreateReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateWebhookRequestWithBody(server, spaceId, "application/json", bodyReader)
+	return NewCreateReleaseRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
 }
 
-// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
-func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateReleaseRequestWithBody generates requests for CreateRelease with any type of body
+func NewCreateReleaseRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8671,12 +9757,19 @@ func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8696,8 +9789,8 @@ func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType
 	return req, nil
 }
 
-// NewDeleteWebhookRequest generates requests for DeleteWebhook
-func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams) (*http.Request, error) {
+// NewDeleteReleaseRequest generates requests for DeleteRelease
+func NewDeleteReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8709,7 +9802,14 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -8719,7 +9819,7 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8734,24 +9834,11 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Version", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewGetWebhookRequest generates requests for GetWebhook
-func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+// NewGetReleaseRequest generates requests for GetRelease
+func NewGetReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8763,7 +9850,14 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -8773,7 +9867,7 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8791,19 +9885,19 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 	return req, nil
 }
 
-// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
-func NewUpdateWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+// NewUpdateReleaseRequest calls the generic UpdateRelease builder with application/json body
+func NewUpdateReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateWebhookRequestWithBody(server, spaceId, webhookId, params, "application/json", bodyReader)
+	return NewUpdateReleaseRequestWithBody(server, spaceId, environmentId, releaseId, params, "application/json", bodyReader)
 }
 
-// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
-func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateReleaseRequestWithBody generates requests for UpdateRelease with any type of body
+func NewUpdateReleaseRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8815,7 +9909,14 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -8825,7 +9926,7 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8858,358 +9959,2982 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetReleaseActionRequest generates requests for GetReleaseAction
+func NewGetReleaseActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId ReleaseActionId) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	var pathParam1 string
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAllAppDefinitionsWithResponse request
-	GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateAppDefinitionWithBodyWithResponse request with any body
-	CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+	var pathParam2 string
 
-	CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAppDefinitionWithResponse request
-	DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error)
+	var pathParam3 string
 
-	// GetAppDefinitionWithResponse request
-	GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error)
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "releaseActionId", runtime.ParamLocationPath, releaseActionId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAppDefinitionWithBodyWithResponse request with any body
-	UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/actions/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateAppBundleWithBodyWithResponse request with any body
-	CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAppEventSubscriptionWithResponse request
-	DeleteAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppEventSubscriptionResponse, error)
+	return req, nil
+}
 
-	// GetAppEventSubscriptionWithResponse request
-	GetAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppEventSubscriptionResponse, error)
+// NewUnpublishReleaseRequest generates requests for UnpublishRelease
+func NewUnpublishReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UnpublishReleaseParams) (*http.Request, error) {
+	var err error
 
-	// UpdateAppEventSubscriptionWithBodyWithResponse request with any body
-	UpdateAppEventSubscriptionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error)
+	var pathParam0 string
 
-	UpdateAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UploadAppWithBodyWithResponse request with any body
-	UploadAppWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAppResponse, error)
+	var pathParam1 string
 
-	// GetAllSpacesWithResponse request
-	GetAllSpacesWithResponse(ctx context.Context, params *GetAllSpacesParams, reqEditors ...RequestEditorFn) (*GetAllSpacesResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateSpaceWithBodyWithResponse request with any body
-	CreateSpaceWithBodyWithResponse(ctx context.Context, params *CreateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
+	var pathParam2 string
 
-	CreateSpaceWithResponse(ctx context.Context, params *CreateSpaceParams, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteSpaceWithResponse request
-	DeleteSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *DeleteSpaceParams, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSpaceWithResponse request
-	GetSpaceWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetSpaceResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateSpaceWithBodyWithResponse request with any body
-	UpdateSpaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllApiKeysWithResponse request
-	GetAllApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllApiKeysResponse, error)
+	if params != nil {
 
-	// CreateApiKeyWithBodyWithResponse request with any body
-	CreateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+		var headerParam0 string
 
-	CreateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
 
-	// DeleteApiKeyWithResponse request
-	DeleteApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams, reqEditors ...RequestEditorFn) (*DeleteApiKeyResponse, error)
+		req.Header.Set("X-Contentful-Version", headerParam0)
 
-	// GetApiKeyWithResponse request
-	GetApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error)
+	}
 
-	// UpdateApiKeyWithBodyWithResponse request with any body
-	UpdateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error)
+	return req, nil
+}
 
-	UpdateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error)
+// NewPublishReleaseRequest generates requests for PublishRelease
+func NewPublishReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams) (*http.Request, error) {
+	var err error
 
-	// GetAllEnvironmentAliasesWithResponse request
-	GetAllEnvironmentAliasesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentAliasesParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentAliasesResponse, error)
+	var pathParam0 string
 
-	// DeleteEnvironmentAliasWithResponse request
-	DeleteEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentAliasResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetEnvironmentAliasWithResponse request
-	GetEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, reqEditors ...RequestEditorFn) (*GetEnvironmentAliasResponse, error)
+	var pathParam1 string
 
-	// UpsertEnvironmentAliasWithBodyWithResponse request with any body
-	UpsertEnvironmentAliasWithBodyWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	UpsertEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error)
+	var pathParam2 string
 
-	// GetAllEnvironmentsWithResponse request
-	GetAllEnvironmentsWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentsResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateEnvironmentWithBodyWithResponse request with any body
-	CreateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteEnvironmentWithResponse request
-	DeleteEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetEnvironmentWithResponse request
-	GetEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateEnvironmentWithBodyWithResponse request with any body
-	UpdateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+	if params != nil {
 
-	UpdateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+		var headerParam0 string
 
-	// GetAllAppInstallationsWithResponse request
-	GetAllAppInstallationsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams, reqEditors ...RequestEditorFn) (*GetAllAppInstallationsResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
 
-	// DeleteAppInstallationWithResponse request
-	DeleteAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams, reqEditors ...RequestEditorFn) (*DeleteAppInstallationResponse, error)
+		req.Header.Set("X-Contentful-Version", headerParam0)
 
-	// GetAppInstallationWithResponse request
-	GetAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppInstallationResponse, error)
+	}
 
-	// UpsertAppInstallationWithBodyWithResponse request with any body
-	UpsertAppInstallationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error)
+	return req, nil
+}
 
-	UpsertAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error)
+// NewValidateReleaseRequest calls the generic ValidateRelease builder with application/json body
+func NewValidateReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, body ValidateReleaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateReleaseRequestWithBody(server, spaceId, environmentId, releaseId, "application/json", bodyReader)
+}
 
-	// GetAllAssetsWithResponse request
-	GetAllAssetsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams, reqEditors ...RequestEditorFn) (*GetAllAssetsResponse, error)
+// NewValidateReleaseRequestWithBody generates requests for ValidateRelease with any type of body
+func NewValidateReleaseRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CreateAssetWithBodyWithResponse request with any body
-	CreateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error)
+	var pathParam0 string
 
-	CreateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAssetWithResponse request
-	DeleteAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams, reqEditors ...RequestEditorFn) (*DeleteAssetResponse, error)
+	var pathParam1 string
 
-	// GetAssetWithResponse request
-	GetAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAssetResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAssetWithBodyWithResponse request with any body
-	UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error)
+	var pathParam2 string
 
-	UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UnarchiveAssetWithResponse request
-	UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ArchiveAssetWithResponse request
-	ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/validate", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ProcessAssetWithResponse request
-	ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UnpublishAssetWithResponse request
-	UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PublishAssetWithResponse request
-	PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetAllContentTypesWithResponse request
-	GetAllContentTypesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*GetAllContentTypesResponse, error)
+	return req, nil
+}
 
-	// CreateContentTypeWithBodyWithResponse request with any body
-	CreateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error)
+// NewCreateUploadRequestWithBody generates requests for CreateUpload with any type of body
+func NewCreateUploadRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error)
+	var pathParam0 string
 
-	// DeleteContentTypeWithResponse request
-	DeleteContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams, reqEditors ...RequestEditorFn) (*DeleteContentTypeResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetContentTypeWithResponse request
-	GetContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetContentTypeResponse, error)
+	var pathParam1 string
 
-	// UpdateContentTypeWithBodyWithResponse request with any body
-	UpdateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetEditorInterfaceWithResponse request
-	GetEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetEditorInterfaceResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/uploads", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateEditorInterfaceWithBodyWithResponse request with any body
-	UpdateEditorInterfaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeactivateContentTypeWithResponse request
-	DeactivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams, reqEditors ...RequestEditorFn) (*DeactivateContentTypeResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ActivateContentTypeWithResponse request
-	ActivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams, reqEditors ...RequestEditorFn) (*ActivateContentTypeResponse, error)
+	return req, nil
+}
 
-	// GetAllEntriesWithResponse request
-	GetAllEntriesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams, reqEditors ...RequestEditorFn) (*GetAllEntriesResponse, error)
+// NewDeleteUploadRequest generates requests for DeleteUpload
+func NewDeleteUploadRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
+	var err error
 
-	// CreateEntryWithBodyWithResponse request with any body
-	CreateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error)
+	var pathParam0 string
 
-	CreateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteEntryWithResponse request
-	DeleteEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams, reqEditors ...RequestEditorFn) (*DeleteEntryResponse, error)
+	var pathParam1 string
 
-	// GetEntryWithResponse request
-	GetEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*GetEntryResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateEntryWithBodyWithResponse request with any body
-	UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error)
+	var pathParam2 string
 
-	UpdateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UnarchiveEntryWithResponse request
-	UnarchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams, reqEditors ...RequestEditorFn) (*UnarchiveEntryResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ArchiveEntryWithResponse request
-	ArchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams, reqEditors ...RequestEditorFn) (*ArchiveEntryResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/uploads/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UnpublishEntryWithResponse request
-	UnpublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*UnpublishEntryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PublishEntryWithResponse request
-	PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllLocalesWithResponse request
-	GetAllLocalesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*GetAllLocalesResponse, error)
+	return req, nil
+}
 
-	// CreateLocaleWithBodyWithResponse request with any body
-	CreateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error)
+// NewGetUploadRequest generates requests for GetUpload
+func NewGetUploadRequest(server string, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId) (*http.Request, error) {
+	var err error
 
-	CreateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error)
+	var pathParam0 string
 
-	// DeleteLocaleWithResponse request
-	DeleteLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*DeleteLocaleResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLocaleWithResponse request
-	GetLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*GetLocaleResponse, error)
+	var pathParam1 string
 
-	// UpdateLocaleWithBodyWithResponse request with any body
-	UpdateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error)
+	var pathParam2 string
 
-	// GetAllPreviewApiKeysWithResponse request
-	GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetPreviewApiKeyWithResponse request
-	GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreatePreviewEnvironmentWithBodyWithResponse request with any body
-	CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/uploads/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeletePreviewEnvironmentWithResponse request
-	DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWorkflowDefinitionRequest calls the generic CreateWorkflowDefinition builder with application/json body
+func NewCreateWorkflowDefinitionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateWorkflowDefinitionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkflowDefinitionRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+}
+
+// NewCreateWorkflowDefinitionRequestWithBody generates requests for CreateWorkflowDefinition with any type of body
+func NewCreateWorkflowDefinitionRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/workflow_definitions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWorkflowDefinitionRequest generates requests for DeleteWorkflowDefinition
+func NewDeleteWorkflowDefinitionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *DeleteWorkflowDefinitionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "workflowDefinitionId", runtime.ParamLocationPath, workflowDefinitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/workflow_definitions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWorkflowDefinitionRequest generates requests for GetWorkflowDefinition
+func NewGetWorkflowDefinitionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "workflowDefinitionId", runtime.ParamLocationPath, workflowDefinitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/workflow_definitions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWorkflowDefinitionRequest calls the generic UpdateWorkflowDefinition builder with application/json body
+func NewUpdateWorkflowDefinitionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, body UpdateWorkflowDefinitionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWorkflowDefinitionRequestWithBody(server, spaceId, environmentId, workflowDefinitionId, params, "application/json", bodyReader)
+}
+
+// NewUpdateWorkflowDefinitionRequestWithBody generates requests for UpdateWorkflowDefinition with any type of body
+func NewUpdateWorkflowDefinitionRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "workflowDefinitionId", runtime.ParamLocationPath, workflowDefinitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/workflow_definitions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAllPreviewApiKeysRequest generates requests for GetAllPreviewApiKeys
+func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetAllPreviewApiKeysParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPreviewApiKeyRequest generates requests for GetPreviewApiKey
+func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePreviewEnvironmentRequest calls the generic CreatePreviewEnvironment builder with application/json body
+func NewCreatePreviewEnvironmentRequest(server string, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePreviewEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewCreatePreviewEnvironmentRequestWithBody generates requests for CreatePreviewEnvironment with any type of body
+func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePreviewEnvironmentRequest generates requests for DeletePreviewEnvironment
+func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetPreviewEnvironmentRequest generates requests for GetPreviewEnvironment
+func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePreviewEnvironmentRequest calls the generic UpdatePreviewEnvironment builder with application/json body
+func NewUpdatePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePreviewEnvironmentRequestWithBody(server, spaceId, resourceId, params, "application/json", bodyReader)
+}
+
+// NewUpdatePreviewEnvironmentRequestWithBody generates requests for UpdatePreviewEnvironment with any type of body
+func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAllRolesRequest generates requests for GetAllRoles
+func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
+func NewCreateRoleRequest(server string, spaceId SpaceId, body CreateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
+func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRoleRequest generates requests for DeleteRole
+func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetRoleRequest generates requests for GetRole
+func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
+func NewUpdateRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleRequestWithBody(server, spaceId, roleId, params, "application/json", bodyReader)
+}
+
+// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
+func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAllWebhooksRequest generates requests for GetAllWebhooks
+func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebhooksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, spaceId SpaceId, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookRequest generates requests for GetWebhook
+func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookRequestWithBody(server, spaceId, webhookId, params, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllAppDefinitionsWithResponse request
+	GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error)
+
+	// CreateAppDefinitionWithBodyWithResponse request with any body
+	CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+
+	CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+
+	// DeleteAppDefinitionWithResponse request
+	DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error)
+
+	// GetAppDefinitionWithResponse request
+	GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error)
+
+	// UpdateAppDefinitionWithBodyWithResponse request with any body
+	UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+
+	UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+
+	// CreateAppActionWithBodyWithResponse request with any body
+	CreateAppActionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppActionResponse, error)
+
+	CreateAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppActionResponse, error)
+
+	// DeleteAppActionWithResponse request
+	DeleteAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*DeleteAppActionResponse, error)
+
+	// GetAppActionWithResponse request
+	GetAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*GetAppActionResponse, error)
+
+	// UpdateAppActionWithBodyWithResponse request with any body
+	UpdateAppActionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppActionResponse, error)
+
+	UpdateAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, body UpdateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppActionResponse, error)
+
+	// CreateAppBundleWithBodyWithResponse request with any body
+	CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+
+	CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+
+	// DeleteAppBundleWithResponse request
+	DeleteAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*DeleteAppBundleResponse, error)
+
+	// GetAppBundleWithResponse request
+	GetAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*GetAppBundleResponse, error)
+
+	// DeleteAppEventSubscriptionWithResponse request
+	DeleteAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppEventSubscriptionResponse, error)
+
+	// GetAppEventSubscriptionWithResponse request
+	GetAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppEventSubscriptionResponse, error)
+
+	// UpdateAppEventSubscriptionWithBodyWithResponse request with any body
+	UpdateAppEventSubscriptionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error)
+
+	UpdateAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error)
+
+	// CreateAppKeyWithBodyWithResponse request with any body
+	CreateAppKeyWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppKeyResponse, error)
+
+	CreateAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppKeyResponse, error)
+
+	// DeleteAppKeyWithResponse request
+	DeleteAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*DeleteAppKeyResponse, error)
+
+	// GetAppKeyWithResponse request
+	GetAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*GetAppKeyResponse, error)
+
+	// DeleteAppSigningSecretWithResponse request
+	DeleteAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppSigningSecretResponse, error)
+
+	// GetAppSigningSecretWithResponse request
+	GetAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppSigningSecretResponse, error)
+
+	// UpdateAppSigningSecretWithBodyWithResponse request with any body
+	UpdateAppSigningSecretWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppSigningSecretResponse, error)
+
+	UpdateAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppSigningSecretResponse, error)
+
+	// UploadAppWithBodyWithResponse request with any body
+	UploadAppWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAppResponse, error)
+
+	// GetAllSpacesWithResponse request
+	GetAllSpacesWithResponse(ctx context.Context, params *GetAllSpacesParams, reqEditors ...RequestEditorFn) (*GetAllSpacesResponse, error)
+
+	// CreateSpaceWithBodyWithResponse request with any body
+	CreateSpaceWithBodyWithResponse(ctx context.Context, params *CreateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
+
+	CreateSpaceWithResponse(ctx context.Context, params *CreateSpaceParams, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error)
+
+	// DeleteSpaceWithResponse request
+	DeleteSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *DeleteSpaceParams, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error)
+
+	// GetSpaceWithResponse request
+	GetSpaceWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetSpaceResponse, error)
+
+	// UpdateSpaceWithBodyWithResponse request with any body
+	UpdateSpaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error)
+
+	UpdateSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error)
+
+	// GetAllApiKeysWithResponse request
+	GetAllApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllApiKeysResponse, error)
+
+	// CreateApiKeyWithBodyWithResponse request with any body
+	CreateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+
+	CreateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error)
+
+	// DeleteApiKeyWithResponse request
+	DeleteApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams, reqEditors ...RequestEditorFn) (*DeleteApiKeyResponse, error)
+
+	// GetApiKeyWithResponse request
+	GetApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error)
+
+	// UpdateApiKeyWithBodyWithResponse request with any body
+	UpdateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error)
+
+	UpdateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error)
+
+	// GetAllEnvironmentAliasesWithResponse request
+	GetAllEnvironmentAliasesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentAliasesParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentAliasesResponse, error)
+
+	// DeleteEnvironmentAliasWithResponse request
+	DeleteEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentAliasResponse, error)
+
+	// GetEnvironmentAliasWithResponse request
+	GetEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, reqEditors ...RequestEditorFn) (*GetEnvironmentAliasResponse, error)
+
+	// UpsertEnvironmentAliasWithBodyWithResponse request with any body
+	UpsertEnvironmentAliasWithBodyWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error)
+
+	UpsertEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error)
+
+	// GetAllEnvironmentsWithResponse request
+	GetAllEnvironmentsWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentsResponse, error)
+
+	// CreateEnvironmentWithBodyWithResponse request with any body
+	CreateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+
+	CreateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+
+	// DeleteEnvironmentWithResponse request
+	DeleteEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentResponse, error)
+
+	// GetEnvironmentWithResponse request
+	GetEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error)
+
+	// UpdateEnvironmentWithBodyWithResponse request with any body
+	UpdateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+
+	UpdateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+
+	// GetAllAppInstallationsWithResponse request
+	GetAllAppInstallationsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams, reqEditors ...RequestEditorFn) (*GetAllAppInstallationsResponse, error)
+
+	// DeleteAppInstallationWithResponse request
+	DeleteAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams, reqEditors ...RequestEditorFn) (*DeleteAppInstallationResponse, error)
+
+	// GetAppInstallationWithResponse request
+	GetAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppInstallationResponse, error)
+
+	// UpsertAppInstallationWithBodyWithResponse request with any body
+	UpsertAppInstallationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error)
+
+	UpsertAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error)
+
+	// GetAllAssetsWithResponse request
+	GetAllAssetsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams, reqEditors ...RequestEditorFn) (*GetAllAssetsResponse, error)
+
+	// CreateAssetWithBodyWithResponse request with any body
+	CreateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error)
+
+	CreateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error)
+
+	// DeleteAssetWithResponse request
+	DeleteAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams, reqEditors ...RequestEditorFn) (*DeleteAssetResponse, error)
+
+	// GetAssetWithResponse request
+	GetAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAssetResponse, error)
+
+	// UpdateAssetWithBodyWithResponse request with any body
+	UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error)
+
+	UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error)
+
+	// UnarchiveAssetWithResponse request
+	UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error)
+
+	// ArchiveAssetWithResponse request
+	ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error)
+
+	// ProcessAssetWithResponse request
+	ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error)
+
+	// UnpublishAssetWithResponse request
+	UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error)
+
+	// PublishAssetWithResponse request
+	PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error)
+
+	// GetAllContentTypesWithResponse request
+	GetAllContentTypesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*GetAllContentTypesResponse, error)
+
+	// CreateContentTypeWithBodyWithResponse request with any body
+	CreateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error)
+
+	CreateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error)
+
+	// DeleteContentTypeWithResponse request
+	DeleteContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams, reqEditors ...RequestEditorFn) (*DeleteContentTypeResponse, error)
+
+	// GetContentTypeWithResponse request
+	GetContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetContentTypeResponse, error)
+
+	// UpdateContentTypeWithBodyWithResponse request with any body
+	UpdateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error)
+
+	UpdateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error)
+
+	// GetEditorInterfaceWithResponse request
+	GetEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetEditorInterfaceResponse, error)
+
+	// UpdateEditorInterfaceWithBodyWithResponse request with any body
+	UpdateEditorInterfaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error)
+
+	UpdateEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error)
+
+	// DeactivateContentTypeWithResponse request
+	DeactivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams, reqEditors ...RequestEditorFn) (*DeactivateContentTypeResponse, error)
+
+	// ActivateContentTypeWithResponse request
+	ActivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams, reqEditors ...RequestEditorFn) (*ActivateContentTypeResponse, error)
+
+	// GetAllEntriesWithResponse request
+	GetAllEntriesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams, reqEditors ...RequestEditorFn) (*GetAllEntriesResponse, error)
+
+	// CreateEntryWithBodyWithResponse request with any body
+	CreateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error)
+
+	CreateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error)
+
+	// DeleteEntryWithResponse request
+	DeleteEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams, reqEditors ...RequestEditorFn) (*DeleteEntryResponse, error)
+
+	// GetEntryWithResponse request
+	GetEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*GetEntryResponse, error)
+
+	// UpdateEntryWithBodyWithResponse request with any body
+	UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error)
+
+	UpdateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error)
+
+	// UnarchiveEntryWithResponse request
+	UnarchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams, reqEditors ...RequestEditorFn) (*UnarchiveEntryResponse, error)
+
+	// ArchiveEntryWithResponse request
+	ArchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams, reqEditors ...RequestEditorFn) (*ArchiveEntryResponse, error)
+
+	// UnpublishEntryWithResponse request
+	UnpublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*UnpublishEntryResponse, error)
+
+	// PublishEntryWithResponse request
+	PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error)
+
+	// GetAllLocalesWithResponse request
+	GetAllLocalesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*GetAllLocalesResponse, error)
+
+	// CreateLocaleWithBodyWithResponse request with any body
+	CreateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error)
+
+	CreateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error)
+
+	// DeleteLocaleWithResponse request
+	DeleteLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*DeleteLocaleResponse, error)
+
+	// GetLocaleWithResponse request
+	GetLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*GetLocaleResponse, error)
+
+	// UpdateLocaleWithBodyWithResponse request with any body
+	UpdateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error)
+
+	UpdateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error)
+
+	// CreateReleaseWithBodyWithResponse request with any body
+	CreateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error)
+
+	CreateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error)
+
+	// DeleteReleaseWithResponse request
+	DeleteReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*DeleteReleaseResponse, error)
+
+	// GetReleaseWithResponse request
+	GetReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*GetReleaseResponse, error)
+
+	// UpdateReleaseWithBodyWithResponse request with any body
+	UpdateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error)
+
+	UpdateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error)
+
+	// GetReleaseActionWithResponse request
+	GetReleaseActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId ReleaseActionId, reqEditors ...RequestEditorFn) (*GetReleaseActionResponse, error)
+
+	// UnpublishReleaseWithResponse request
+	UnpublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UnpublishReleaseParams, reqEditors ...RequestEditorFn) (*UnpublishReleaseResponse, error)
+
+	// PublishReleaseWithResponse request
+	PublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*PublishReleaseResponse, error)
+
+	// ValidateReleaseWithBodyWithResponse request with any body
+	ValidateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateReleaseResponse, error)
+
+	ValidateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, body ValidateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateReleaseResponse, error)
+
+	// CreateUploadWithBodyWithResponse request with any body
+	CreateUploadWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error)
+
+	// DeleteUploadWithResponse request
+	DeleteUploadWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteUploadResponse, error)
+
+	// GetUploadWithResponse request
+	GetUploadWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetUploadResponse, error)
+
+	// CreateWorkflowDefinitionWithBodyWithResponse request with any body
+	CreateWorkflowDefinitionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkflowDefinitionResponse, error)
+
+	CreateWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkflowDefinitionResponse, error)
+
+	// DeleteWorkflowDefinitionWithResponse request
+	DeleteWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *DeleteWorkflowDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteWorkflowDefinitionResponse, error)
+
+	// GetWorkflowDefinitionWithResponse request
+	GetWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, reqEditors ...RequestEditorFn) (*GetWorkflowDefinitionResponse, error)
+
+	// UpdateWorkflowDefinitionWithBodyWithResponse request with any body
+	UpdateWorkflowDefinitionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkflowDefinitionResponse, error)
+
+	UpdateWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, body UpdateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkflowDefinitionResponse, error)
+
+	// GetAllPreviewApiKeysWithResponse request
+	GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error)
+
+	// GetPreviewApiKeyWithResponse request
+	GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error)
+
+	// CreatePreviewEnvironmentWithBodyWithResponse request with any body
+	CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+
+	CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+
+	// DeletePreviewEnvironmentWithResponse request
+	DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error)
+
+	// GetPreviewEnvironmentWithResponse request
+	GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error)
+
+	// UpdatePreviewEnvironmentWithBodyWithResponse request with any body
+	UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+
+	UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+
+	// GetAllRolesWithResponse request
+	GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error)
+
+	// CreateRoleWithBodyWithResponse request with any body
+	CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	// DeleteRoleWithResponse request
+	DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error)
+
+	// GetRoleWithResponse request
+	GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error)
+
+	// UpdateRoleWithBodyWithResponse request with any body
+	UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	// GetAllWebhooksWithResponse request
+	GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// GetWebhookWithResponse request
+	GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+
+	// UpdateWebhookWithBodyWithResponse request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+}
+
+type GetAllAppDefinitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinitionCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllAppDefinitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAppDefinitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppAction
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppAction
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppAction
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppBundle
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppBundle
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppEventSubscription
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppEventSubscription
+	JSON201      *AppEventSubscription
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppKey
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppSigningSecret
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppSigningSecret
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Sys SystemPropertiesBase `json:"sys"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllSpacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpaceCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllSpacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllSpacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Space
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Space
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Space
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllApiKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKeyCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllApiKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllApiKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ApiKey
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateApiKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKey
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateApiKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateApiKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllEnvironmentAliasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentAliasCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllEnvironmentAliasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllEnvironmentAliasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnvironmentAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnvironmentAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvironmentAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentAlias
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertEnvironmentAliasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentAlias
+	JSON201      *EnvironmentAlias
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertEnvironmentAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertEnvironmentAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllEnvironmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Environment
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Environment
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Environment
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllAppInstallationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppInstallationCollection
+}
 
-	// GetPreviewEnvironmentWithResponse request
-	GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetAllAppInstallationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePreviewEnvironmentWithBodyWithResponse request with any body
-	UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAppInstallationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+type DeleteAppInstallationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	// GetAllRolesWithResponse request
-	GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeleteAppInstallationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CreateRoleWithBodyWithResponse request with any body
-	CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppInstallationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+type GetAppInstallationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppInstallation
+}
 
-	// DeleteRoleWithResponse request
-	DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetAppInstallationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetRoleWithResponse request
-	GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppInstallationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateRoleWithBodyWithResponse request with any body
-	UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+type UpsertAppInstallationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppInstallation
+	JSON201      *AppInstallation
+}
 
-	UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpsertAppInstallationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetAllWebhooksWithResponse request
-	GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertAppInstallationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreateWebhookWithBodyWithResponse request with any body
-	CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+type GetAllAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetCollection
+}
 
-	CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetAllAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteWebhookWithResponse request
-	DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetWebhookWithResponse request
-	GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+type CreateAssetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Asset
+}
 
-	// UpdateWebhookWithBodyWithResponse request with any body
-	UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreateAssetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAssetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetAllAppDefinitionsResponse struct {
+type DeleteAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinitionCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAppDefinitionsResponse) Status() string {
+func (r DeleteAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9217,21 +12942,21 @@ func (r GetAllAppDefinitionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAppDefinitionsResponse) StatusCode() int {
+func (r DeleteAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAppDefinitionResponse struct {
+type GetAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *AppDefinition
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAppDefinitionResponse) Status() string {
+func (r GetAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9239,20 +12964,22 @@ func (r CreateAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppDefinitionResponse) StatusCode() int {
+func (r GetAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppDefinitionResponse struct {
+type UpdateAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Asset
+	JSON201      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppDefinitionResponse) Status() string {
+func (r UpdateAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9260,21 +12987,21 @@ func (r DeleteAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppDefinitionResponse) StatusCode() int {
+func (r UpdateAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppDefinitionResponse struct {
+type UnarchiveAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinition
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppDefinitionResponse) Status() string {
+func (r UnarchiveAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9282,21 +13009,21 @@ func (r GetAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppDefinitionResponse) StatusCode() int {
+func (r UnarchiveAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppDefinitionResponse struct {
+type ArchiveAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinition
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppDefinitionResponse) Status() string {
+func (r ArchiveAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9304,23 +13031,20 @@ func (r UpdateAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppDefinitionResponse) StatusCode() int {
+func (r ArchiveAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAppBundleResponse struct {
+type ProcessAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *struct {
-		Sys SystemPropertiesBase `json:"sys"`
-	}
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAppBundleResponse) Status() string {
+func (r ProcessAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9328,20 +13052,21 @@ func (r CreateAppBundleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppBundleResponse) StatusCode() int {
+func (r ProcessAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppEventSubscriptionResponse struct {
+type UnpublishAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppEventSubscriptionResponse) Status() string {
+func (r UnpublishAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9349,21 +13074,21 @@ func (r DeleteAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppEventSubscriptionResponse) StatusCode() int {
+func (r UnpublishAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppEventSubscriptionResponse struct {
+type PublishAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppEventSubscription
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppEventSubscriptionResponse) Status() string {
+func (r PublishAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9371,22 +13096,21 @@ func (r GetAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppEventSubscriptionResponse) StatusCode() int {
+func (r PublishAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppEventSubscriptionResponse struct {
+type GetAllContentTypesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppEventSubscription
-	JSON201      *AppEventSubscription
+	JSON200      *ContentTypeCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppEventSubscriptionResponse) Status() string {
+func (r GetAllContentTypesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9394,23 +13118,21 @@ func (r UpdateAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppEventSubscriptionResponse) StatusCode() int {
+func (r GetAllContentTypesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UploadAppResponse struct {
+type CreateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *struct {
-		Sys SystemPropertiesBase `json:"sys"`
-	}
+	JSON201      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r UploadAppResponse) Status() string {
+func (r CreateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9418,21 +13140,20 @@ func (r UploadAppResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UploadAppResponse) StatusCode() int {
+func (r CreateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllSpacesResponse struct {
+type DeleteContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SpaceCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllSpacesResponse) Status() string {
+func (r DeleteContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9440,21 +13161,21 @@ func (r GetAllSpacesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllSpacesResponse) StatusCode() int {
+func (r DeleteContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateSpaceResponse struct {
+type GetContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Space
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateSpaceResponse) Status() string {
+func (r GetContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9462,20 +13183,22 @@ func (r CreateSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateSpaceResponse) StatusCode() int {
+func (r GetContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteSpaceResponse struct {
+type UpdateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ContentType
+	JSON201      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteSpaceResponse) Status() string {
+func (r UpdateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9483,21 +13206,21 @@ func (r DeleteSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSpaceResponse) StatusCode() int {
+func (r UpdateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSpaceResponse struct {
+type GetEditorInterfaceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Space
+	JSON200      *EditorInterface
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSpaceResponse) Status() string {
+func (r GetEditorInterfaceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9505,21 +13228,21 @@ func (r GetSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSpaceResponse) StatusCode() int {
+func (r GetEditorInterfaceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateSpaceResponse struct {
+type UpdateEditorInterfaceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Space
+	JSON200      *EditorInterface
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateSpaceResponse) Status() string {
+func (r UpdateEditorInterfaceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9527,21 +13250,21 @@ func (r UpdateSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSpaceResponse) StatusCode() int {
+func (r UpdateEditorInterfaceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllApiKeysResponse struct {
+type DeactivateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKeyCollection
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllApiKeysResponse) Status() string {
+func (r DeactivateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9549,21 +13272,21 @@ func (r GetAllApiKeysResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllApiKeysResponse) StatusCode() int {
+func (r DeactivateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateApiKeyResponse struct {
+type ActivateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ApiKey
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateApiKeyResponse) Status() string {
+func (r ActivateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9571,20 +13294,21 @@ func (r CreateApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateApiKeyResponse) StatusCode() int {
+func (r ActivateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteApiKeyResponse struct {
+type GetAllEntriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *EntryCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteApiKeyResponse) Status() string {
+func (r GetAllEntriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9592,21 +13316,21 @@ func (r DeleteApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiKeyResponse) StatusCode() int {
+func (r GetAllEntriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetApiKeyResponse struct {
+type CreateEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKey
+	JSON201      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetApiKeyResponse) Status() string {
+func (r CreateEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9614,21 +13338,20 @@ func (r GetApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetApiKeyResponse) StatusCode() int {
+func (r CreateEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateApiKeyResponse struct {
+type DeleteEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateApiKeyResponse) Status() string {
+func (r DeleteEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9636,21 +13359,21 @@ func (r UpdateApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateApiKeyResponse) StatusCode() int {
+func (r DeleteEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllEnvironmentAliasesResponse struct {
+type GetEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EnvironmentAliasCollection
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllEnvironmentAliasesResponse) Status() string {
+func (r GetEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9658,20 +13381,22 @@ func (r GetAllEnvironmentAliasesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllEnvironmentAliasesResponse) StatusCode() int {
+func (r GetEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteEnvironmentAliasResponse struct {
+type UpdateEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Entry
+	JSON201      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteEnvironmentAliasResponse) Status() string {
+func (r UpdateEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9679,21 +13404,21 @@ func (r DeleteEnvironmentAliasResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvironmentAliasResponse) StatusCode() int {
+func (r UpdateEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEnvironmentAliasResponse struct {
+type UnarchiveEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EnvironmentAlias
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEnvironmentAliasResponse) Status() string {
+func (r UnarchiveEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9701,22 +13426,21 @@ func (r GetEnvironmentAliasResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentAliasResponse) StatusCode() int {
+func (r UnarchiveEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpsertEnvironmentAliasResponse struct {
+type ArchiveEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EnvironmentAlias
-	JSON201      *EnvironmentAlias
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r UpsertEnvironmentAliasResponse) Status() string {
+func (r ArchiveEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9724,21 +13448,21 @@ func (r UpsertEnvironmentAliasResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpsertEnvironmentAliasResponse) StatusCode() int {
+func (r ArchiveEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllEnvironmentsResponse struct {
+type UnpublishEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EnvironmentCollection
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllEnvironmentsResponse) Status() string {
+func (r UnpublishEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9746,21 +13470,21 @@ func (r GetAllEnvironmentsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllEnvironmentsResponse) StatusCode() int {
+func (r UnpublishEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateEnvironmentResponse struct {
+type PublishEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Environment
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateEnvironmentResponse) Status() string {
+func (r PublishEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9768,20 +13492,21 @@ func (r CreateEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateEnvironmentResponse) StatusCode() int {
+func (r PublishEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteEnvironmentResponse struct {
+type GetAllLocalesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *LocaleCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteEnvironmentResponse) Status() string {
+func (r GetAllLocalesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9789,21 +13514,21 @@ func (r DeleteEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvironmentResponse) StatusCode() int {
+func (r GetAllLocalesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEnvironmentResponse struct {
+type CreateLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Environment
+	JSON201      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEnvironmentResponse) Status() string {
+func (r CreateLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9811,21 +13536,20 @@ func (r GetEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentResponse) StatusCode() int {
+func (r CreateLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEnvironmentResponse struct {
+type DeleteLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Environment
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEnvironmentResponse) Status() string {
+func (r DeleteLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9833,21 +13557,21 @@ func (r UpdateEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEnvironmentResponse) StatusCode() int {
+func (r DeleteLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllAppInstallationsResponse struct {
+type GetLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallationCollection
+	JSON200      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAppInstallationsResponse) Status() string {
+func (r GetLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9855,20 +13579,21 @@ func (r GetAllAppInstallationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAppInstallationsResponse) StatusCode() int {
+func (r GetLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppInstallationResponse struct {
+type UpdateLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppInstallationResponse) Status() string {
+func (r UpdateLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9876,21 +13601,21 @@ func (r DeleteAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppInstallationResponse) StatusCode() int {
+func (r UpdateLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppInstallationResponse struct {
+type CreateReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallation
+	JSON201      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppInstallationResponse) Status() string {
+func (r CreateReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9898,22 +13623,20 @@ func (r GetAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppInstallationResponse) StatusCode() int {
+func (r CreateReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpsertAppInstallationResponse struct {
+type DeleteReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallation
-	JSON201      *AppInstallation
 }
 
 // Status returns HTTPResponse.Status
-func (r UpsertAppInstallationResponse) Status() string {
+func (r DeleteReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9921,21 +13644,21 @@ func (r UpsertAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpsertAppInstallationResponse) StatusCode() int {
+func (r DeleteReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllAssetsResponse struct {
+type GetReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AssetCollection
+	JSON200      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAssetsResponse) Status() string {
+func (r GetReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9943,21 +13666,21 @@ func (r GetAllAssetsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAssetsResponse) StatusCode() int {
+func (r GetReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAssetResponse struct {
+type UpdateReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Asset
+	JSON200      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAssetResponse) Status() string {
+func (r UpdateReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9965,20 +13688,21 @@ func (r CreateAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAssetResponse) StatusCode() int {
+func (r UpdateReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAssetResponse struct {
+type GetReleaseActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAssetResponse) Status() string {
+func (r GetReleaseActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9986,21 +13710,21 @@ func (r DeleteAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAssetResponse) StatusCode() int {
+func (r GetReleaseActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAssetResponse struct {
+type UnpublishReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON202      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAssetResponse) Status() string {
+func (r UnpublishReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10008,22 +13732,21 @@ func (r GetAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAssetResponse) StatusCode() int {
+func (r UnpublishReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAssetResponse struct {
+type PublishReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
-	JSON201      *Asset
+	JSON202      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAssetResponse) Status() string {
+func (r PublishReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10031,21 +13754,21 @@ func (r UpdateAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAssetResponse) StatusCode() int {
+func (r PublishReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnarchiveAssetResponse struct {
+type ValidateReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON202      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r UnarchiveAssetResponse) Status() string {
+func (r ValidateReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10053,21 +13776,21 @@ func (r UnarchiveAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnarchiveAssetResponse) StatusCode() int {
+func (r ValidateReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveAssetResponse struct {
+type CreateUploadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON201      *Upload
 }
 
 // Status returns HTTPResponse.Status
-func (r ArchiveAssetResponse) Status() string {
+func (r CreateUploadResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10075,20 +13798,20 @@ func (r ArchiveAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveAssetResponse) StatusCode() int {
+func (r CreateUploadResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ProcessAssetResponse struct {
+type DeleteUploadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r ProcessAssetResponse) Status() string {
+func (r DeleteUploadResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10096,21 +13819,21 @@ func (r ProcessAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ProcessAssetResponse) StatusCode() int {
+func (r DeleteUploadResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnpublishAssetResponse struct {
+type GetUploadResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON200      *Upload
 }
 
 // Status returns HTTPResponse.Status
-func (r UnpublishAssetResponse) Status() string {
+func (r GetUploadResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10118,21 +13841,21 @@ func (r UnpublishAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnpublishAssetResponse) StatusCode() int {
+func (r GetUploadResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PublishAssetResponse struct {
+type CreateWorkflowDefinitionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON201      *WorkflowDefinition
 }
 
 // Status returns HTTPResponse.Status
-func (r PublishAssetResponse) Status() string {
+func (r CreateWorkflowDefinitionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10140,21 +13863,20 @@ func (r PublishAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PublishAssetResponse) StatusCode() int {
+func (r CreateWorkflowDefinitionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllContentTypesResponse struct {
+type DeleteWorkflowDefinitionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentTypeCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllContentTypesResponse) Status() string {
+func (r DeleteWorkflowDefinitionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10162,21 +13884,21 @@ func (r GetAllContentTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllContentTypesResponse) StatusCode() int {
+func (r DeleteWorkflowDefinitionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateContentTypeResponse struct {
+type GetWorkflowDefinitionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ContentType
+	JSON200      *WorkflowDefinition
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateContentTypeResponse) Status() string {
+func (r GetWorkflowDefinitionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10184,20 +13906,21 @@ func (r CreateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateContentTypeResponse) StatusCode() int {
+func (r GetWorkflowDefinitionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteContentTypeResponse struct {
+type UpdateWorkflowDefinitionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowDefinition
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteContentTypeResponse) Status() string {
+func (r UpdateWorkflowDefinitionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10205,21 +13928,21 @@ func (r DeleteContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteContentTypeResponse) StatusCode() int {
+func (r UpdateWorkflowDefinitionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetContentTypeResponse struct {
+type GetAllPreviewApiKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *PreviewApiKeyCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetContentTypeResponse) Status() string {
+func (r GetAllPreviewApiKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10227,22 +13950,21 @@ func (r GetContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetContentTypeResponse) StatusCode() int {
+func (r GetAllPreviewApiKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateContentTypeResponse struct {
+type GetPreviewApiKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
-	JSON201      *ContentType
+	JSON200      *PreviewApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateContentTypeResponse) Status() string {
+func (r GetPreviewApiKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10250,21 +13972,21 @@ func (r UpdateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateContentTypeResponse) StatusCode() int {
+func (r GetPreviewApiKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEditorInterfaceResponse struct {
+type CreatePreviewEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EditorInterface
+	JSON201      *PreviewEnvironment
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEditorInterfaceResponse) Status() string {
+func (r CreatePreviewEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10272,21 +13994,20 @@ func (r GetEditorInterfaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEditorInterfaceResponse) StatusCode() int {
+func (r CreatePreviewEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEditorInterfaceResponse struct {
+type DeletePreviewEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EditorInterface
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEditorInterfaceResponse) Status() string {
+func (r DeletePreviewEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10294,21 +14015,21 @@ func (r UpdateEditorInterfaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEditorInterfaceResponse) StatusCode() int {
+func (r DeletePreviewEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeactivateContentTypeResponse struct {
+type GetPreviewEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *PreviewEnvironment
 }
 
 // Status returns HTTPResponse.Status
-func (r DeactivateContentTypeResponse) Status() string {
+func (r GetPreviewEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10316,21 +14037,21 @@ func (r DeactivateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeactivateContentTypeResponse) StatusCode() int {
+func (r GetPreviewEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ActivateContentTypeResponse struct {
+type UpdatePreviewEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *PreviewEnvironment
 }
 
 // Status returns HTTPResponse.Status
-func (r ActivateContentTypeResponse) Status() string {
+func (r UpdatePreviewEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10338,21 +14059,21 @@ func (r ActivateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ActivateContentTypeResponse) StatusCode() int {
+func (r UpdatePreviewEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllEntriesResponse struct {
+type GetAllRolesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EntryCollection
+	JSON200      *RoleCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllEntriesResponse) Status() string {
+func (r GetAllRolesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10360,21 +14081,21 @@ func (r GetAllEntriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllEntriesResponse) StatusCode() int {
+func (r GetAllRolesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateEntryResponse struct {
+type CreateRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Entry
+	JSON201      *Role
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateEntryResponse) Status() string {
+func (r CreateRoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10382,20 +14103,20 @@ func (r CreateEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateEntryResponse) StatusCode() int {
+func (r CreateRoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteEntryResponse struct {
+type DeleteRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteEntryResponse) Status() string {
+func (r DeleteRoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10403,21 +14124,21 @@ func (r DeleteEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEntryResponse) StatusCode() int {
+func (r DeleteRoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEntryResponse struct {
+type GetRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Role
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEntryResponse) Status() string {
+func (r GetRoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10425,22 +14146,21 @@ func (r GetEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEntryResponse) StatusCode() int {
+func (r GetRoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEntryResponse struct {
+type UpdateRoleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
-	JSON201      *Entry
+	JSON200      *Role
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEntryResponse) Status() string {
+func (r UpdateRoleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10448,21 +14168,21 @@ func (r UpdateEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEntryResponse) StatusCode() int {
+func (r UpdateRoleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnarchiveEntryResponse struct {
+type GetAllWebhooksResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *WebhookCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r UnarchiveEntryResponse) Status() string {
+func (r GetAllWebhooksResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10470,21 +14190,21 @@ func (r UnarchiveEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnarchiveEntryResponse) StatusCode() int {
+func (r GetAllWebhooksResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveEntryResponse struct {
+type CreateWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Webhook
 }
 
 // Status returns HTTPResponse.Status
-func (r ArchiveEntryResponse) Status() string {
+func (r CreateWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10492,21 +14212,20 @@ func (r ArchiveEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveEntryResponse) StatusCode() int {
+func (r CreateWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnpublishEntryResponse struct {
+type DeleteWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r UnpublishEntryResponse) Status() string {
+func (r DeleteWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10514,21 +14233,21 @@ func (r UnpublishEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnpublishEntryResponse) StatusCode() int {
+func (r DeleteWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PublishEntryResponse struct {
+type GetWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Webhook
 }
 
 // Status returns HTTPResponse.Status
-func (r PublishEntryResponse) Status() string {
+func (r GetWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10536,21 +14255,21 @@ func (r PublishEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PublishEntryResponse) StatusCode() int {
+func (r GetWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllLocalesResponse struct {
+type UpdateWebhookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LocaleCollection
+	JSON200      *Webhook
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllLocalesResponse) Status() string {
+func (r UpdateWebhookResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10558,1419 +14277,1819 @@ func (r GetAllLocalesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllLocalesResponse) StatusCode() int {
+func (r UpdateWebhookResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateLocaleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Locale
+// GetAllAppDefinitionsWithResponse request returning *GetAllAppDefinitionsResponse
+func (c *ClientWithResponses) GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error) {
+	rsp, err := c.GetAllAppDefinitions(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllAppDefinitionsResponse(rsp)
+}
+
+// CreateAppDefinitionWithBodyWithResponse request with arbitrary body returning *CreateAppDefinitionResponse
+func (c *ClientWithResponses) CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error) {
+	rsp, err := c.CreateAppDefinitionWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppDefinitionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error) {
+	rsp, err := c.CreateAppDefinition(ctx, organizationId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppDefinitionResponse(rsp)
+}
+
+// DeleteAppDefinitionWithResponse request returning *DeleteAppDefinitionResponse
+func (c *ClientWithResponses) DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error) {
+	rsp, err := c.DeleteAppDefinition(ctx, organizationId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppDefinitionResponse(rsp)
+}
+
+// GetAppDefinitionWithResponse request returning *GetAppDefinitionResponse
+func (c *ClientWithResponses) GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error) {
+	rsp, err := c.GetAppDefinition(ctx, organizationId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppDefinitionResponse(rsp)
+}
+
+// UpdateAppDefinitionWithBodyWithResponse request with arbitrary body returning *UpdateAppDefinitionResponse
+func (c *ClientWithResponses) UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error) {
+	rsp, err := c.UpdateAppDefinitionWithBody(ctx, organizationId, resourceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppDefinitionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error) {
+	rsp, err := c.UpdateAppDefinition(ctx, organizationId, resourceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppDefinitionResponse(rsp)
+}
+
+// CreateAppActionWithBodyWithResponse request with arbitrary body returning *CreateAppActionResponse
+func (c *ClientWithResponses) CreateAppActionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppActionResponse, error) {
+	rsp, err := c.CreateAppActionWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppActionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppActionResponse, error) {
+	rsp, err := c.CreateAppAction(ctx, organizationId, resourceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppActionResponse(rsp)
+}
+
+// DeleteAppActionWithResponse request returning *DeleteAppActionResponse
+func (c *ClientWithResponses) DeleteAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*DeleteAppActionResponse, error) {
+	rsp, err := c.DeleteAppAction(ctx, organizationId, resourceId, appActionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppActionResponse(rsp)
+}
+
+// GetAppActionWithResponse request returning *GetAppActionResponse
+func (c *ClientWithResponses) GetAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, reqEditors ...RequestEditorFn) (*GetAppActionResponse, error) {
+	rsp, err := c.GetAppAction(ctx, organizationId, resourceId, appActionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppActionResponse(rsp)
+}
+
+// UpdateAppActionWithBodyWithResponse request with arbitrary body returning *UpdateAppActionResponse
+func (c *ClientWithResponses) UpdateAppActionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppActionResponse, error) {
+	rsp, err := c.UpdateAppActionWithBody(ctx, organizationId, resourceId, appActionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppActionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppActionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appActionId AppActionId, body UpdateAppActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppActionResponse, error) {
+	rsp, err := c.UpdateAppAction(ctx, organizationId, resourceId, appActionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppActionResponse(rsp)
+}
+
+// CreateAppBundleWithBodyWithResponse request with arbitrary body returning *CreateAppBundleResponse
+func (c *ClientWithResponses) CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error) {
+	rsp, err := c.CreateAppBundleWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppBundleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error) {
+	rsp, err := c.CreateAppBundle(ctx, organizationId, resourceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppBundleResponse(rsp)
+}
+
+// DeleteAppBundleWithResponse request returning *DeleteAppBundleResponse
+func (c *ClientWithResponses) DeleteAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*DeleteAppBundleResponse, error) {
+	rsp, err := c.DeleteAppBundle(ctx, organizationId, resourceId, appBundleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppBundleResponse(rsp)
+}
+
+// GetAppBundleWithResponse request returning *GetAppBundleResponse
+func (c *ClientWithResponses) GetAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appBundleId AppBundleId, reqEditors ...RequestEditorFn) (*GetAppBundleResponse, error) {
+	rsp, err := c.GetAppBundle(ctx, organizationId, resourceId, appBundleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppBundleResponse(rsp)
+}
+
+// DeleteAppEventSubscriptionWithResponse request returning *DeleteAppEventSubscriptionResponse
+func (c *ClientWithResponses) DeleteAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppEventSubscriptionResponse, error) {
+	rsp, err := c.DeleteAppEventSubscription(ctx, organizationId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppEventSubscriptionResponse(rsp)
+}
+
+// GetAppEventSubscriptionWithResponse request returning *GetAppEventSubscriptionResponse
+func (c *ClientWithResponses) GetAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppEventSubscriptionResponse, error) {
+	rsp, err := c.GetAppEventSubscription(ctx, organizationId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppEventSubscriptionResponse(rsp)
+}
+
+// UpdateAppEventSubscriptionWithBodyWithResponse request with arbitrary body returning *UpdateAppEventSubscriptionResponse
+func (c *ClientWithResponses) UpdateAppEventSubscriptionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error) {
+	rsp, err := c.UpdateAppEventSubscriptionWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppEventSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error) {
+	rsp, err := c.UpdateAppEventSubscription(ctx, organizationId, resourceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppEventSubscriptionResponse(rsp)
+}
+
+// CreateAppKeyWithBodyWithResponse request with arbitrary body returning *CreateAppKeyResponse
+func (c *ClientWithResponses) CreateAppKeyWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppKeyResponse, error) {
+	rsp, err := c.CreateAppKeyWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppKeyResponse, error) {
+	rsp, err := c.CreateAppKey(ctx, organizationId, resourceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppKeyResponse(rsp)
+}
+
+// DeleteAppKeyWithResponse request returning *DeleteAppKeyResponse
+func (c *ClientWithResponses) DeleteAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*DeleteAppKeyResponse, error) {
+	rsp, err := c.DeleteAppKey(ctx, organizationId, resourceId, appKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppKeyResponse(rsp)
+}
+
+// GetAppKeyWithResponse request returning *GetAppKeyResponse
+func (c *ClientWithResponses) GetAppKeyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, appKeyId AppKeyId, reqEditors ...RequestEditorFn) (*GetAppKeyResponse, error) {
+	rsp, err := c.GetAppKey(ctx, organizationId, resourceId, appKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppKeyResponse(rsp)
+}
+
+// DeleteAppSigningSecretWithResponse request returning *DeleteAppSigningSecretResponse
+func (c *ClientWithResponses) DeleteAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppSigningSecretResponse, error) {
+	rsp, err := c.DeleteAppSigningSecret(ctx, organizationId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppSigningSecretResponse(rsp)
+}
+
+// GetAppSigningSecretWithResponse request returning *GetAppSigningSecretResponse
+func (c *ClientWithResponses) GetAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppSigningSecretResponse, error) {
+	rsp, err := c.GetAppSigningSecret(ctx, organizationId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppSigningSecretResponse(rsp)
+}
+
+// UpdateAppSigningSecretWithBodyWithResponse request with arbitrary body returning *UpdateAppSigningSecretResponse
+func (c *ClientWithResponses) UpdateAppSigningSecretWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppSigningSecretResponse, error) {
+	rsp, err := c.UpdateAppSigningSecretWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppSigningSecretResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppSigningSecretWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppSigningSecretResponse, error) {
+	rsp, err := c.UpdateAppSigningSecret(ctx, organizationId, resourceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppSigningSecretResponse(rsp)
+}
+
+// UploadAppWithBodyWithResponse request with arbitrary body returning *UploadAppResponse
+func (c *ClientWithResponses) UploadAppWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAppResponse, error) {
+	rsp, err := c.UploadAppWithBody(ctx, organizationId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadAppResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateLocaleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetAllSpacesWithResponse request returning *GetAllSpacesResponse
+func (c *ClientWithResponses) GetAllSpacesWithResponse(ctx context.Context, params *GetAllSpacesParams, reqEditors ...RequestEditorFn) (*GetAllSpacesResponse, error) {
+	rsp, err := c.GetAllSpaces(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetAllSpacesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateLocaleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateSpaceWithBodyWithResponse request with arbitrary body returning *CreateSpaceResponse
+func (c *ClientWithResponses) CreateSpaceWithBodyWithResponse(ctx context.Context, params *CreateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
+	rsp, err := c.CreateSpaceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateSpaceResponse(rsp)
 }
 
-type DeleteLocaleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) CreateSpaceWithResponse(ctx context.Context, params *CreateSpaceParams, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
+	rsp, err := c.CreateSpace(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSpaceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteLocaleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteSpaceWithResponse request returning *DeleteSpaceResponse
+func (c *ClientWithResponses) DeleteSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *DeleteSpaceParams, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error) {
+	rsp, err := c.DeleteSpace(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteSpaceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteLocaleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSpaceWithResponse request returning *GetSpaceResponse
+func (c *ClientWithResponses) GetSpaceWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetSpaceResponse, error) {
+	rsp, err := c.GetSpace(ctx, spaceId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSpaceResponse(rsp)
 }
 
-type GetLocaleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Locale
+// UpdateSpaceWithBodyWithResponse request with arbitrary body returning *UpdateSpaceResponse
+func (c *ClientWithResponses) UpdateSpaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error) {
+	rsp, err := c.UpdateSpaceWithBody(ctx, spaceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSpaceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetLocaleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error) {
+	rsp, err := c.UpdateSpace(ctx, spaceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateSpaceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLocaleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAllApiKeysWithResponse request returning *GetAllApiKeysResponse
+func (c *ClientWithResponses) GetAllApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllApiKeysResponse, error) {
+	rsp, err := c.GetAllApiKeys(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAllApiKeysResponse(rsp)
 }
 
-type UpdateLocaleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Locale
+// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResponse
+func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKeyWithBody(ctx, spaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateLocaleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
+	rsp, err := c.CreateApiKey(ctx, spaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateApiKeyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateLocaleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteApiKeyWithResponse request returning *DeleteApiKeyResponse
+func (c *ClientWithResponses) DeleteApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams, reqEditors ...RequestEditorFn) (*DeleteApiKeyResponse, error) {
+	rsp, err := c.DeleteApiKey(ctx, spaceId, apiKeyId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteApiKeyResponse(rsp)
 }
 
-type GetAllPreviewApiKeysResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PreviewApiKeyCollection
+// GetApiKeyWithResponse request returning *GetApiKeyResponse
+func (c *ClientWithResponses) GetApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error) {
+	rsp, err := c.GetApiKey(ctx, spaceId, apiKeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiKeyResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAllPreviewApiKeysResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateApiKeyWithBodyWithResponse request with arbitrary body returning *UpdateApiKeyResponse
+func (c *ClientWithResponses) UpdateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error) {
+	rsp, err := c.UpdateApiKeyWithBody(ctx, spaceId, apiKeyId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateApiKeyResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllPreviewApiKeysResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error) {
+	rsp, err := c.UpdateApiKey(ctx, spaceId, apiKeyId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateApiKeyResponse(rsp)
 }
 
-type GetPreviewApiKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PreviewApiKey
+// GetAllEnvironmentAliasesWithResponse request returning *GetAllEnvironmentAliasesResponse
+func (c *ClientWithResponses) GetAllEnvironmentAliasesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentAliasesParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentAliasesResponse, error) {
+	rsp, err := c.GetAllEnvironmentAliases(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllEnvironmentAliasesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPreviewApiKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteEnvironmentAliasWithResponse request returning *DeleteEnvironmentAliasResponse
+func (c *ClientWithResponses) DeleteEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentAliasResponse, error) {
+	rsp, err := c.DeleteEnvironmentAlias(ctx, spaceId, aliasId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteEnvironmentAliasResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPreviewApiKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetEnvironmentAliasWithResponse request returning *GetEnvironmentAliasResponse
+func (c *ClientWithResponses) GetEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, reqEditors ...RequestEditorFn) (*GetEnvironmentAliasResponse, error) {
+	rsp, err := c.GetEnvironmentAlias(ctx, spaceId, aliasId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetEnvironmentAliasResponse(rsp)
 }
 
-type CreatePreviewEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *PreviewEnvironment
+// UpsertEnvironmentAliasWithBodyWithResponse request with arbitrary body returning *UpsertEnvironmentAliasResponse
+func (c *ClientWithResponses) UpsertEnvironmentAliasWithBodyWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error) {
+	rsp, err := c.UpsertEnvironmentAliasWithBody(ctx, spaceId, aliasId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertEnvironmentAliasResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreatePreviewEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpsertEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId AliasId, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error) {
+	rsp, err := c.UpsertEnvironmentAlias(ctx, spaceId, aliasId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpsertEnvironmentAliasResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePreviewEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAllEnvironmentsWithResponse request returning *GetAllEnvironmentsResponse
+func (c *ClientWithResponses) GetAllEnvironmentsWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentsResponse, error) {
+	rsp, err := c.GetAllEnvironments(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAllEnvironmentsResponse(rsp)
 }
 
-type DeletePreviewEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CreateEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateEnvironmentResponse
+func (c *ClientWithResponses) CreateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
+	rsp, err := c.CreateEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePreviewEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
+	rsp, err := c.CreateEnvironment(ctx, spaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateEnvironmentResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePreviewEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteEnvironmentWithResponse request returning *DeleteEnvironmentResponse
+func (c *ClientWithResponses) DeleteEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentResponse, error) {
+	rsp, err := c.DeleteEnvironment(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteEnvironmentResponse(rsp)
 }
 
-type GetPreviewEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PreviewEnvironment
+// GetEnvironmentWithResponse request returning *GetEnvironmentResponse
+func (c *ClientWithResponses) GetEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error) {
+	rsp, err := c.GetEnvironment(ctx, spaceId, environmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPreviewEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateEnvironmentResponse
+func (c *ClientWithResponses) UpdateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
+	rsp, err := c.UpdateEnvironmentWithBody(ctx, spaceId, environmentId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateEnvironmentResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPreviewEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
+	rsp, err := c.UpdateEnvironment(ctx, spaceId, environmentId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateEnvironmentResponse(rsp)
 }
 
-type UpdatePreviewEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PreviewEnvironment
+// GetAllAppInstallationsWithResponse request returning *GetAllAppInstallationsResponse
+func (c *ClientWithResponses) GetAllAppInstallationsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams, reqEditors ...RequestEditorFn) (*GetAllAppInstallationsResponse, error) {
+	rsp, err := c.GetAllAppInstallations(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllAppInstallationsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePreviewEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteAppInstallationWithResponse request returning *DeleteAppInstallationResponse
+func (c *ClientWithResponses) DeleteAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams, reqEditors ...RequestEditorFn) (*DeleteAppInstallationResponse, error) {
+	rsp, err := c.DeleteAppInstallation(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteAppInstallationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePreviewEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAppInstallationWithResponse request returning *GetAppInstallationResponse
+func (c *ClientWithResponses) GetAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppInstallationResponse, error) {
+	rsp, err := c.GetAppInstallation(ctx, spaceId, environmentId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAppInstallationResponse(rsp)
 }
 
-type GetAllRolesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RoleCollection
+// UpsertAppInstallationWithBodyWithResponse request with arbitrary body returning *UpsertAppInstallationResponse
+func (c *ClientWithResponses) UpsertAppInstallationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error) {
+	rsp, err := c.UpsertAppInstallationWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppInstallationResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAllRolesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpsertAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error) {
+	rsp, err := c.UpsertAppInstallation(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpsertAppInstallationResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllRolesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAllAssetsWithResponse request returning *GetAllAssetsResponse
+func (c *ClientWithResponses) GetAllAssetsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams, reqEditors ...RequestEditorFn) (*GetAllAssetsResponse, error) {
+	rsp, err := c.GetAllAssets(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAllAssetsResponse(rsp)
 }
 
-type CreateRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Role
+// CreateAssetWithBodyWithResponse request with arbitrary body returning *CreateAssetResponse
+func (c *ClientWithResponses) CreateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error) {
+	rsp, err := c.CreateAssetWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAssetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error) {
+	rsp, err := c.CreateAsset(ctx, spaceId, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateAssetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteAssetWithResponse request returning *DeleteAssetResponse
+func (c *ClientWithResponses) DeleteAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams, reqEditors ...RequestEditorFn) (*DeleteAssetResponse, error) {
+	rsp, err := c.DeleteAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteAssetResponse(rsp)
 }
 
-type DeleteRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetAssetWithResponse request returning *GetAssetResponse
+func (c *ClientWithResponses) GetAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAssetResponse, error) {
+	rsp, err := c.GetAsset(ctx, spaceId, environmentId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateAssetWithBodyWithResponse request with arbitrary body returning *UpdateAssetResponse
+func (c *ClientWithResponses) UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
+	rsp, err := c.UpdateAssetWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateAssetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
+	rsp, err := c.UpdateAsset(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateAssetResponse(rsp)
 }
 
-type GetRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Role
+// UnarchiveAssetWithResponse request returning *UnarchiveAssetResponse
+func (c *ClientWithResponses) UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error) {
+	rsp, err := c.UnarchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnarchiveAssetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ArchiveAssetWithResponse request returning *ArchiveAssetResponse
+func (c *ClientWithResponses) ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error) {
+	rsp, err := c.ArchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseArchiveAssetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ProcessAssetWithResponse request returning *ProcessAssetResponse
+func (c *ClientWithResponses) ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error) {
+	rsp, err := c.ProcessAsset(ctx, spaceId, environmentId, resourceId, localeCode, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseProcessAssetResponse(rsp)
 }
 
-type UpdateRoleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Role
+// UnpublishAssetWithResponse request returning *UnpublishAssetResponse
+func (c *ClientWithResponses) UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error) {
+	rsp, err := c.UnpublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnpublishAssetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PublishAssetWithResponse request returning *PublishAssetResponse
+func (c *ClientWithResponses) PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error) {
+	rsp, err := c.PublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePublishAssetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAllContentTypesWithResponse request returning *GetAllContentTypesResponse
+func (c *ClientWithResponses) GetAllContentTypesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*GetAllContentTypesResponse, error) {
+	rsp, err := c.GetAllContentTypes(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAllContentTypesResponse(rsp)
 }
 
-type GetAllWebhooksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookCollection
+// CreateContentTypeWithBodyWithResponse request with arbitrary body returning *CreateContentTypeResponse
+func (c *ClientWithResponses) CreateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error) {
+	rsp, err := c.CreateContentTypeWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContentTypeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAllWebhooksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error) {
+	rsp, err := c.CreateContentType(ctx, spaceId, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateContentTypeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllWebhooksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteContentTypeWithResponse request returning *DeleteContentTypeResponse
+func (c *ClientWithResponses) DeleteContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams, reqEditors ...RequestEditorFn) (*DeleteContentTypeResponse, error) {
+	rsp, err := c.DeleteContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteContentTypeResponse(rsp)
 }
 
-type CreateWebhookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Webhook
+// GetContentTypeWithResponse request returning *GetContentTypeResponse
+func (c *ClientWithResponses) GetContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetContentTypeResponse, error) {
+	rsp, err := c.GetContentType(ctx, spaceId, environmentId, contentTypeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentTypeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateWebhookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateContentTypeWithBodyWithResponse request with arbitrary body returning *UpdateContentTypeResponse
+func (c *ClientWithResponses) UpdateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error) {
+	rsp, err := c.UpdateContentTypeWithBody(ctx, spaceId, environmentId, contentTypeId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateContentTypeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateWebhookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error) {
+	rsp, err := c.UpdateContentType(ctx, spaceId, environmentId, contentTypeId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateContentTypeResponse(rsp)
 }
 
-type DeleteWebhookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetEditorInterfaceWithResponse request returning *GetEditorInterfaceResponse
+func (c *ClientWithResponses) GetEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetEditorInterfaceResponse, error) {
+	rsp, err := c.GetEditorInterface(ctx, spaceId, environmentId, contentTypeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEditorInterfaceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteWebhookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateEditorInterfaceWithBodyWithResponse request with arbitrary body returning *UpdateEditorInterfaceResponse
+func (c *ClientWithResponses) UpdateEditorInterfaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error) {
+	rsp, err := c.UpdateEditorInterfaceWithBody(ctx, spaceId, environmentId, contentTypeId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateEditorInterfaceResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWebhookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error) {
+	rsp, err := c.UpdateEditorInterface(ctx, spaceId, environmentId, contentTypeId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateEditorInterfaceResponse(rsp)
 }
 
-type GetWebhookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Webhook
+// DeactivateContentTypeWithResponse request returning *DeactivateContentTypeResponse
+func (c *ClientWithResponses) DeactivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams, reqEditors ...RequestEditorFn) (*DeactivateContentTypeResponse, error) {
+	rsp, err := c.DeactivateContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeactivateContentTypeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetWebhookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ActivateContentTypeWithResponse request returning *ActivateContentTypeResponse
+func (c *ClientWithResponses) ActivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams, reqEditors ...RequestEditorFn) (*ActivateContentTypeResponse, error) {
+	rsp, err := c.ActivateContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseActivateContentTypeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWebhookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAllEntriesWithResponse request returning *GetAllEntriesResponse
+func (c *ClientWithResponses) GetAllEntriesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams, reqEditors ...RequestEditorFn) (*GetAllEntriesResponse, error) {
+	rsp, err := c.GetAllEntries(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAllEntriesResponse(rsp)
 }
 
-type UpdateWebhookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Webhook
+// CreateEntryWithBodyWithResponse request with arbitrary body returning *CreateEntryResponse
+func (c *ClientWithResponses) CreateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error) {
+	rsp, err := c.CreateEntryWithBody(ctx, spaceId, environmentId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEntryResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateWebhookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error) {
+	rsp, err := c.CreateEntry(ctx, spaceId, environmentId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateEntryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateWebhookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteEntryWithResponse request returning *DeleteEntryResponse
+func (c *ClientWithResponses) DeleteEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams, reqEditors ...RequestEditorFn) (*DeleteEntryResponse, error) {
+	rsp, err := c.DeleteEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteEntryResponse(rsp)
 }
 
-// GetAllAppDefinitionsWithResponse request returning *GetAllAppDefinitionsResponse
-func (c *ClientWithResponses) GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error) {
-	rsp, err := c.GetAllAppDefinitions(ctx, organizationId, params, reqEditors...)
+// GetEntryWithResponse request returning *GetEntryResponse
+func (c *ClientWithResponses) GetEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*GetEntryResponse, error) {
+	rsp, err := c.GetEntry(ctx, spaceId, environmentId, entryId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllAppDefinitionsResponse(rsp)
+	return ParseGetEntryResponse(rsp)
 }
 
-// CreateAppDefinitionWithBodyWithResponse request with arbitrary body returning *CreateAppDefinitionResponse
-func (c *ClientWithResponses) CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error) {
-	rsp, err := c.CreateAppDefinitionWithBody(ctx, organizationId, contentType, body, reqEditors...)
+// UpdateEntryWithBodyWithResponse request with arbitrary body returning *UpdateEntryResponse
+func (c *ClientWithResponses) UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error) {
+	rsp, err := c.UpdateEntryWithBody(ctx, spaceId, environmentId, entryId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppDefinitionResponse(rsp)
+	return ParseUpdateEntryResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error) {
-	rsp, err := c.CreateAppDefinition(ctx, organizationId, body, reqEditors...)
+func (c *ClientWithResponses) UpdateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error) {
+	rsp, err := c.UpdateEntry(ctx, spaceId, environmentId, entryId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppDefinitionResponse(rsp)
+	return ParseUpdateEntryResponse(rsp)
 }
 
-// DeleteAppDefinitionWithResponse request returning *DeleteAppDefinitionResponse
-func (c *ClientWithResponses) DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error) {
-	rsp, err := c.DeleteAppDefinition(ctx, organizationId, resourceId, params, reqEditors...)
+// UnarchiveEntryWithResponse request returning *UnarchiveEntryResponse
+func (c *ClientWithResponses) UnarchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams, reqEditors ...RequestEditorFn) (*UnarchiveEntryResponse, error) {
+	rsp, err := c.UnarchiveEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAppDefinitionResponse(rsp)
+	return ParseUnarchiveEntryResponse(rsp)
 }
 
-// GetAppDefinitionWithResponse request returning *GetAppDefinitionResponse
-func (c *ClientWithResponses) GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error) {
-	rsp, err := c.GetAppDefinition(ctx, organizationId, resourceId, reqEditors...)
+// ArchiveEntryWithResponse request returning *ArchiveEntryResponse
+func (c *ClientWithResponses) ArchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams, reqEditors ...RequestEditorFn) (*ArchiveEntryResponse, error) {
+	rsp, err := c.ArchiveEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppDefinitionResponse(rsp)
+	return ParseArchiveEntryResponse(rsp)
 }
 
-// UpdateAppDefinitionWithBodyWithResponse request with arbitrary body returning *UpdateAppDefinitionResponse
-func (c *ClientWithResponses) UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error) {
-	rsp, err := c.UpdateAppDefinitionWithBody(ctx, organizationId, resourceId, params, contentType, body, reqEditors...)
+// UnpublishEntryWithResponse request returning *UnpublishEntryResponse
+func (c *ClientWithResponses) UnpublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*UnpublishEntryResponse, error) {
+	rsp, err := c.UnpublishEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppDefinitionResponse(rsp)
+	return ParseUnpublishEntryResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error) {
-	rsp, err := c.UpdateAppDefinition(ctx, organizationId, resourceId, params, body, reqEditors...)
+// PublishEntryWithResponse request returning *PublishEntryResponse
+func (c *ClientWithResponses) PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error) {
+	rsp, err := c.PublishEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppDefinitionResponse(rsp)
+	return ParsePublishEntryResponse(rsp)
 }
 
-// CreateAppBundleWithBodyWithResponse request with arbitrary body returning *CreateAppBundleResponse
-func (c *ClientWithResponses) CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error) {
-	rsp, err := c.CreateAppBundleWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+// GetAllLocalesWithResponse request returning *GetAllLocalesResponse
+func (c *ClientWithResponses) GetAllLocalesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*GetAllLocalesResponse, error) {
+	rsp, err := c.GetAllLocales(ctx, spaceId, environmentId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppBundleResponse(rsp)
+	return ParseGetAllLocalesResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error) {
-	rsp, err := c.CreateAppBundle(ctx, organizationId, resourceId, body, reqEditors...)
+// CreateLocaleWithBodyWithResponse request with arbitrary body returning *CreateLocaleResponse
+func (c *ClientWithResponses) CreateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error) {
+	rsp, err := c.CreateLocaleWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppBundleResponse(rsp)
+	return ParseCreateLocaleResponse(rsp)
 }
 
-// DeleteAppEventSubscriptionWithResponse request returning *DeleteAppEventSubscriptionResponse
-func (c *ClientWithResponses) DeleteAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppEventSubscriptionResponse, error) {
-	rsp, err := c.DeleteAppEventSubscription(ctx, organizationId, resourceId, reqEditors...)
+func (c *ClientWithResponses) CreateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error) {
+	rsp, err := c.CreateLocale(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAppEventSubscriptionResponse(rsp)
+	return ParseCreateLocaleResponse(rsp)
 }
 
-// GetAppEventSubscriptionWithResponse request returning *GetAppEventSubscriptionResponse
-func (c *ClientWithResponses) GetAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppEventSubscriptionResponse, error) {
-	rsp, err := c.GetAppEventSubscription(ctx, organizationId, resourceId, reqEditors...)
+// DeleteLocaleWithResponse request returning *DeleteLocaleResponse
+func (c *ClientWithResponses) DeleteLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*DeleteLocaleResponse, error) {
+	rsp, err := c.DeleteLocale(ctx, spaceId, environmentId, localeId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppEventSubscriptionResponse(rsp)
+	return ParseDeleteLocaleResponse(rsp)
 }
 
-// UpdateAppEventSubscriptionWithBodyWithResponse request with arbitrary body returning *UpdateAppEventSubscriptionResponse
-func (c *ClientWithResponses) UpdateAppEventSubscriptionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error) {
-	rsp, err := c.UpdateAppEventSubscriptionWithBody(ctx, organizationId, resourceId, contentType, body, reqEditors...)
+// GetLocaleWithResponse request returning *GetLocaleResponse
+func (c *ClientWithResponses) GetLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*GetLocaleResponse, error) {
+	rsp, err := c.GetLocale(ctx, spaceId, environmentId, localeId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppEventSubscriptionResponse(rsp)
+	return ParseGetLocaleResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body UpdateAppEventSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppEventSubscriptionResponse, error) {
-	rsp, err := c.UpdateAppEventSubscription(ctx, organizationId, resourceId, body, reqEditors...)
+// UpdateLocaleWithBodyWithResponse request with arbitrary body returning *UpdateLocaleResponse
+func (c *ClientWithResponses) UpdateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error) {
+	rsp, err := c.UpdateLocaleWithBody(ctx, spaceId, environmentId, localeId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppEventSubscriptionResponse(rsp)
+	return ParseUpdateLocaleResponse(rsp)
 }
 
-// UploadAppWithBodyWithResponse request with arbitrary body returning *UploadAppResponse
-func (c *ClientWithResponses) UploadAppWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAppResponse, error) {
-	rsp, err := c.UploadAppWithBody(ctx, organizationId, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error) {
+	rsp, err := c.UpdateLocale(ctx, spaceId, environmentId, localeId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUploadAppResponse(rsp)
+	return ParseUpdateLocaleResponse(rsp)
 }
 
-// GetAllSpacesWithResponse request returning *GetAllSpacesResponse
-func (c *ClientWithResponses) GetAllSpacesWithResponse(ctx context.Context, params *GetAllSpacesParams, reqEditors ...RequestEditorFn) (*GetAllSpacesResponse, error) {
-	rsp, err := c.GetAllSpaces(ctx, params, reqEditors...)
+// CreateReleaseWithBodyWithResponse request with arbitrary body returning *CreateReleaseResponse
+func (c *ClientWithResponses) CreateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error) {
+	rsp, err := c.CreateReleaseWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllSpacesResponse(rsp)
+	return ParseCreateReleaseResponse(rsp)
 }
 
-// CreateSpaceWithBodyWithResponse request with arbitrary body returning *CreateSpaceResponse
-func (c *ClientWithResponses) CreateSpaceWithBodyWithResponse(ctx context.Context, params *CreateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
-	rsp, err := c.CreateSpaceWithBody(ctx, params, contentType, body, reqEditors...)
+func (c *ClientWithResponses) CreateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error) {
+	rsp, err := c.CreateRelease(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSpaceResponse(rsp)
+	return ParseCreateReleaseResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateSpaceWithResponse(ctx context.Context, params *CreateSpaceParams, body CreateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSpaceResponse, error) {
-	rsp, err := c.CreateSpace(ctx, params, body, reqEditors...)
+// DeleteReleaseWithResponse request returning *DeleteReleaseResponse
+func (c *ClientWithResponses) DeleteReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*DeleteReleaseResponse, error) {
+	rsp, err := c.DeleteRelease(ctx, spaceId, environmentId, releaseId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSpaceResponse(rsp)
+	return ParseDeleteReleaseResponse(rsp)
 }
 
-// DeleteSpaceWithResponse request returning *DeleteSpaceResponse
-func (c *ClientWithResponses) DeleteSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *DeleteSpaceParams, reqEditors ...RequestEditorFn) (*DeleteSpaceResponse, error) {
-	rsp, err := c.DeleteSpace(ctx, spaceId, params, reqEditors...)
+// GetReleaseWithResponse request returning *GetReleaseResponse
+func (c *ClientWithResponses) GetReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*GetReleaseResponse, error) {
+	rsp, err := c.GetRelease(ctx, spaceId, environmentId, releaseId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteSpaceResponse(rsp)
+	return ParseGetReleaseResponse(rsp)
 }
 
-// GetSpaceWithResponse request returning *GetSpaceResponse
-func (c *ClientWithResponses) GetSpaceWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetSpaceResponse, error) {
-	rsp, err := c.GetSpace(ctx, spaceId, reqEditors...)
+// UpdateReleaseWithBodyWithResponse request with arbitrary body returning *UpdateReleaseResponse
+func (c *ClientWithResponses) UpdateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error) {
+	rsp, err := c.UpdateReleaseWithBody(ctx, spaceId, environmentId, releaseId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSpaceResponse(rsp)
+	return ParseUpdateReleaseResponse(rsp)
 }
 
-// UpdateSpaceWithBodyWithResponse request with arbitrary body returning *UpdateSpaceResponse
-func (c *ClientWithResponses) UpdateSpaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error) {
-	rsp, err := c.UpdateSpaceWithBody(ctx, spaceId, params, contentType, body, reqEditors...)
+func (c *ClientWithResponses) UpdateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error) {
+	rsp, err := c.UpdateRelease(ctx, spaceId, environmentId, releaseId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSpaceResponse(rsp)
+	return ParseUpdateReleaseResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateSpaceWithResponse(ctx context.Context, spaceId SpaceId, params *UpdateSpaceParams, body UpdateSpaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSpaceResponse, error) {
-	rsp, err := c.UpdateSpace(ctx, spaceId, params, body, reqEditors...)
+// GetReleaseActionWithResponse request returning *GetReleaseActionResponse
+func (c *ClientWithResponses) GetReleaseActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId ReleaseActionId, reqEditors ...RequestEditorFn) (*GetReleaseActionResponse, error) {
+	rsp, err := c.GetReleaseAction(ctx, spaceId, environmentId, releaseId, releaseActionId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSpaceResponse(rsp)
+	return ParseGetReleaseActionResponse(rsp)
 }
-
-// GetAllApiKeysWithResponse request returning *GetAllApiKeysResponse
-func (c *ClientWithResponses) GetAllApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllApiKeysResponse, error) {
-	rsp, err := c.GetAllApiKeys(ctx, spaceId, params, reqEditors...)
+
+// UnpublishReleaseWithResponse request returning *UnpublishReleaseResponse
+func (c *ClientWithResponses) UnpublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UnpublishReleaseParams, reqEditors ...RequestEditorFn) (*UnpublishReleaseResponse, error) {
+	rsp, err := c.UnpublishRelease(ctx, spaceId, environmentId, releaseId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllApiKeysResponse(rsp)
+	return ParseUnpublishReleaseResponse(rsp)
 }
 
-// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResponse
-func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
-	rsp, err := c.CreateApiKeyWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// PublishReleaseWithResponse request returning *PublishReleaseResponse
+func (c *ClientWithResponses) PublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*PublishReleaseResponse, error) {
+	rsp, err := c.PublishRelease(ctx, spaceId, environmentId, releaseId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateApiKeyResponse(rsp)
+	return ParsePublishReleaseResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResponse, error) {
-	rsp, err := c.CreateApiKey(ctx, spaceId, body, reqEditors...)
+// ValidateReleaseWithBodyWithResponse request with arbitrary body returning *ValidateReleaseResponse
+func (c *ClientWithResponses) ValidateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateReleaseResponse, error) {
+	rsp, err := c.ValidateReleaseWithBody(ctx, spaceId, environmentId, releaseId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateApiKeyResponse(rsp)
+	return ParseValidateReleaseResponse(rsp)
 }
 
-// DeleteApiKeyWithResponse request returning *DeleteApiKeyResponse
-func (c *ClientWithResponses) DeleteApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *DeleteApiKeyParams, reqEditors ...RequestEditorFn) (*DeleteApiKeyResponse, error) {
-	rsp, err := c.DeleteApiKey(ctx, spaceId, apiKeyId, params, reqEditors...)
+func (c *ClientWithResponses) ValidateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, body ValidateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateReleaseResponse, error) {
+	rsp, err := c.ValidateRelease(ctx, spaceId, environmentId, releaseId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteApiKeyResponse(rsp)
+	return ParseValidateReleaseResponse(rsp)
 }
 
-// GetApiKeyWithResponse request returning *GetApiKeyResponse
-func (c *ClientWithResponses) GetApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, reqEditors ...RequestEditorFn) (*GetApiKeyResponse, error) {
-	rsp, err := c.GetApiKey(ctx, spaceId, apiKeyId, reqEditors...)
+// CreateUploadWithBodyWithResponse request with arbitrary body returning *CreateUploadResponse
+func (c *ClientWithResponses) CreateUploadWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUploadResponse, error) {
+	rsp, err := c.CreateUploadWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetApiKeyResponse(rsp)
+	return ParseCreateUploadResponse(rsp)
 }
 
-// UpdateApiKeyWithBodyWithResponse request with arbitrary body returning *UpdateApiKeyResponse
-func (c *ClientWithResponses) UpdateApiKeyWithBodyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error) {
-	rsp, err := c.UpdateApiKeyWithBody(ctx, spaceId, apiKeyId, params, contentType, body, reqEditors...)
+// DeleteUploadWithResponse request returning *DeleteUploadResponse
+func (c *ClientWithResponses) DeleteUploadWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteUploadResponse, error) {
+	rsp, err := c.DeleteUpload(ctx, spaceId, environmentId, resourceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateApiKeyResponse(rsp)
+	return ParseDeleteUploadResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateApiKeyWithResponse(ctx context.Context, spaceId SpaceId, apiKeyId ApiKeyId, params *UpdateApiKeyParams, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResponse, error) {
-	rsp, err := c.UpdateApiKey(ctx, spaceId, apiKeyId, params, body, reqEditors...)
+// GetUploadWithResponse request returning *GetUploadResponse
+func (c *ClientWithResponses) GetUploadWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetUploadResponse, error) {
+	rsp, err := c.GetUpload(ctx, spaceId, environmentId, resourceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateApiKeyResponse(rsp)
+	return ParseGetUploadResponse(rsp)
 }
 
-// GetAllEnvironmentAliasesWithResponse request returning *GetAllEnvironmentAliasesResponse
-func (c *ClientWithResponses) GetAllEnvironmentAliasesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentAliasesParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentAliasesResponse, error) {
-	rsp, err := c.GetAllEnvironmentAliases(ctx, spaceId, params, reqEditors...)
+// CreateWorkflowDefinitionWithBodyWithResponse request with arbitrary body returning *CreateWorkflowDefinitionResponse
+func (c *ClientWithResponses) CreateWorkflowDefinitionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkflowDefinitionResponse, error) {
+	rsp, err := c.CreateWorkflowDefinitionWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllEnvironmentAliasesResponse(rsp)
+	return ParseCreateWorkflowDefinitionResponse(rsp)
 }
 
-// DeleteEnvironmentAliasWithResponse request returning *DeleteEnvironmentAliasResponse
-func (c *ClientWithResponses) DeleteEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *DeleteEnvironmentAliasParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentAliasResponse, error) {
-	rsp, err := c.DeleteEnvironmentAlias(ctx, spaceId, aliasId, params, reqEditors...)
+func (c *ClientWithResponses) CreateWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkflowDefinitionResponse, error) {
+	rsp, err := c.CreateWorkflowDefinition(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteEnvironmentAliasResponse(rsp)
+	return ParseCreateWorkflowDefinitionResponse(rsp)
 }
 
-// GetEnvironmentAliasWithResponse request returning *GetEnvironmentAliasResponse
-func (c *ClientWithResponses) GetEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, reqEditors ...RequestEditorFn) (*GetEnvironmentAliasResponse, error) {
-	rsp, err := c.GetEnvironmentAlias(ctx, spaceId, aliasId, reqEditors...)
+// DeleteWorkflowDefinitionWithResponse request returning *DeleteWorkflowDefinitionResponse
+func (c *ClientWithResponses) DeleteWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *DeleteWorkflowDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteWorkflowDefinitionResponse, error) {
+	rsp, err := c.DeleteWorkflowDefinition(ctx, spaceId, environmentId, workflowDefinitionId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentAliasResponse(rsp)
+	return ParseDeleteWorkflowDefinitionResponse(rsp)
 }
 
-// UpsertEnvironmentAliasWithBodyWithResponse request with arbitrary body returning *UpsertEnvironmentAliasResponse
-func (c *ClientWithResponses) UpsertEnvironmentAliasWithBodyWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error) {
-	rsp, err := c.UpsertEnvironmentAliasWithBody(ctx, spaceId, aliasId, params, contentType, body, reqEditors...)
+// GetWorkflowDefinitionWithResponse request returning *GetWorkflowDefinitionResponse
+func (c *ClientWithResponses) GetWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, reqEditors ...RequestEditorFn) (*GetWorkflowDefinitionResponse, error) {
+	rsp, err := c.GetWorkflowDefinition(ctx, spaceId, environmentId, workflowDefinitionId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpsertEnvironmentAliasResponse(rsp)
+	return ParseGetWorkflowDefinitionResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpsertEnvironmentAliasWithResponse(ctx context.Context, spaceId SpaceId, aliasId string, params *UpsertEnvironmentAliasParams, body UpsertEnvironmentAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEnvironmentAliasResponse, error) {
-	rsp, err := c.UpsertEnvironmentAlias(ctx, spaceId, aliasId, params, body, reqEditors...)
+// UpdateWorkflowDefinitionWithBodyWithResponse request with arbitrary body returning *UpdateWorkflowDefinitionResponse
+func (c *ClientWithResponses) UpdateWorkflowDefinitionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkflowDefinitionResponse, error) {
+	rsp, err := c.UpdateWorkflowDefinitionWithBody(ctx, spaceId, environmentId, workflowDefinitionId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpsertEnvironmentAliasResponse(rsp)
+	return ParseUpdateWorkflowDefinitionResponse(rsp)
 }
 
-// GetAllEnvironmentsWithResponse request returning *GetAllEnvironmentsResponse
-func (c *ClientWithResponses) GetAllEnvironmentsWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllEnvironmentsParams, reqEditors ...RequestEditorFn) (*GetAllEnvironmentsResponse, error) {
-	rsp, err := c.GetAllEnvironments(ctx, spaceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdateWorkflowDefinitionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, workflowDefinitionId WorkflowDefinitionId, params *UpdateWorkflowDefinitionParams, body UpdateWorkflowDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkflowDefinitionResponse, error) {
+	rsp, err := c.UpdateWorkflowDefinition(ctx, spaceId, environmentId, workflowDefinitionId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllEnvironmentsResponse(rsp)
+	return ParseUpdateWorkflowDefinitionResponse(rsp)
 }
 
-// CreateEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateEnvironmentResponse
-func (c *ClientWithResponses) CreateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
-	rsp, err := c.CreateEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// GetAllPreviewApiKeysWithResponse request returning *GetAllPreviewApiKeysResponse
+func (c *ClientWithResponses) GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error) {
+	rsp, err := c.GetAllPreviewApiKeys(ctx, spaceId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEnvironmentResponse(rsp)
+	return ParseGetAllPreviewApiKeysResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
-	rsp, err := c.CreateEnvironment(ctx, spaceId, body, reqEditors...)
+// GetPreviewApiKeyWithResponse request returning *GetPreviewApiKeyResponse
+func (c *ClientWithResponses) GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error) {
+	rsp, err := c.GetPreviewApiKey(ctx, spaceId, resourceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEnvironmentResponse(rsp)
+	return ParseGetPreviewApiKeyResponse(rsp)
 }
 
-// DeleteEnvironmentWithResponse request returning *DeleteEnvironmentResponse
-func (c *ClientWithResponses) DeleteEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *DeleteEnvironmentParams, reqEditors ...RequestEditorFn) (*DeleteEnvironmentResponse, error) {
-	rsp, err := c.DeleteEnvironment(ctx, spaceId, environmentId, params, reqEditors...)
+// CreatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *CreatePreviewEnvironmentResponse
+func (c *ClientWithResponses) CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
+	rsp, err := c.CreatePreviewEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteEnvironmentResponse(rsp)
+	return ParseCreatePreviewEnvironmentResponse(rsp)
 }
 
-// GetEnvironmentWithResponse request returning *GetEnvironmentResponse
-func (c *ClientWithResponses) GetEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error) {
-	rsp, err := c.GetEnvironment(ctx, spaceId, environmentId, reqEditors...)
+func (c *ClientWithResponses) CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
+	rsp, err := c.CreatePreviewEnvironment(ctx, spaceId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEnvironmentResponse(rsp)
+	return ParseCreatePreviewEnvironmentResponse(rsp)
 }
 
-// UpdateEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateEnvironmentResponse
-func (c *ClientWithResponses) UpdateEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
-	rsp, err := c.UpdateEnvironmentWithBody(ctx, spaceId, environmentId, params, contentType, body, reqEditors...)
+// DeletePreviewEnvironmentWithResponse request returning *DeletePreviewEnvironmentResponse
+func (c *ClientWithResponses) DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error) {
+	rsp, err := c.DeletePreviewEnvironment(ctx, spaceId, resourceId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEnvironmentResponse(rsp)
+	return ParseDeletePreviewEnvironmentResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *UpdateEnvironmentParams, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
-	rsp, err := c.UpdateEnvironment(ctx, spaceId, environmentId, params, body, reqEditors...)
+// GetPreviewEnvironmentWithResponse request returning *GetPreviewEnvironmentResponse
+func (c *ClientWithResponses) GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error) {
+	rsp, err := c.GetPreviewEnvironment(ctx, spaceId, resourceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEnvironmentResponse(rsp)
+	return ParseGetPreviewEnvironmentResponse(rsp)
 }
 
-// GetAllAppInstallationsWithResponse request returning *GetAllAppInstallationsResponse
-func (c *ClientWithResponses) GetAllAppInstallationsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAppInstallationsParams, reqEditors ...RequestEditorFn) (*GetAllAppInstallationsResponse, error) {
-	rsp, err := c.GetAllAppInstallations(ctx, spaceId, environmentId, params, reqEditors...)
+// UpdatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdatePreviewEnvironmentResponse
+func (c *ClientWithResponses) UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
+	rsp, err := c.UpdatePreviewEnvironmentWithBody(ctx, spaceId, resourceId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllAppInstallationsResponse(rsp)
+	return ParseUpdatePreviewEnvironmentResponse(rsp)
 }
 
-// DeleteAppInstallationWithResponse request returning *DeleteAppInstallationResponse
-func (c *ClientWithResponses) DeleteAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAppInstallationParams, reqEditors ...RequestEditorFn) (*DeleteAppInstallationResponse, error) {
-	rsp, err := c.DeleteAppInstallation(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
+	rsp, err := c.UpdatePreviewEnvironment(ctx, spaceId, resourceId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAppInstallationResponse(rsp)
+	return ParseUpdatePreviewEnvironmentResponse(rsp)
 }
 
-// GetAppInstallationWithResponse request returning *GetAppInstallationResponse
-func (c *ClientWithResponses) GetAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppInstallationResponse, error) {
-	rsp, err := c.GetAppInstallation(ctx, spaceId, environmentId, resourceId, reqEditors...)
+// GetAllRolesWithResponse request returning *GetAllRolesResponse
+func (c *ClientWithResponses) GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error) {
+	rsp, err := c.GetAllRoles(ctx, spaceId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppInstallationResponse(rsp)
+	return ParseGetAllRolesResponse(rsp)
 }
 
-// UpsertAppInstallationWithBodyWithResponse request with arbitrary body returning *UpsertAppInstallationResponse
-func (c *ClientWithResponses) UpsertAppInstallationWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error) {
-	rsp, err := c.UpsertAppInstallationWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
+func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRoleWithBody(ctx, spaceId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpsertAppInstallationResponse(rsp)
+	return ParseCreateRoleResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpsertAppInstallationWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpsertAppInstallationParams, body UpsertAppInstallationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppInstallationResponse, error) {
-	rsp, err := c.UpsertAppInstallation(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRole(ctx, spaceId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpsertAppInstallationResponse(rsp)
+	return ParseCreateRoleResponse(rsp)
 }
 
-// GetAllAssetsWithResponse request returning *GetAllAssetsResponse
-func (c *ClientWithResponses) GetAllAssetsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllAssetsParams, reqEditors ...RequestEditorFn) (*GetAllAssetsResponse, error) {
-	rsp, err := c.GetAllAssets(ctx, spaceId, environmentId, params, reqEditors...)
+// DeleteRoleWithResponse request returning *DeleteRoleResponse
+func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
+	rsp, err := c.DeleteRole(ctx, spaceId, roleId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllAssetsResponse(rsp)
+	return ParseDeleteRoleResponse(rsp)
 }
 
-// CreateAssetWithBodyWithResponse request with arbitrary body returning *CreateAssetResponse
-func (c *ClientWithResponses) CreateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error) {
-	rsp, err := c.CreateAssetWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
+// GetRoleWithResponse request returning *GetRoleResponse
+func (c *ClientWithResponses) GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
+	rsp, err := c.GetRole(ctx, spaceId, roleId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAssetResponse(rsp)
+	return ParseGetRoleResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAssetResponse, error) {
-	rsp, err := c.CreateAsset(ctx, spaceId, environmentId, body, reqEditors...)
+// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
+func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRoleWithBody(ctx, spaceId, roleId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAssetResponse(rsp)
+	return ParseUpdateRoleResponse(rsp)
 }
 
-// DeleteAssetWithResponse request returning *DeleteAssetResponse
-func (c *ClientWithResponses) DeleteAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *DeleteAssetParams, reqEditors ...RequestEditorFn) (*DeleteAssetResponse, error) {
-	rsp, err := c.DeleteAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRole(ctx, spaceId, roleId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAssetResponse(rsp)
+	return ParseUpdateRoleResponse(rsp)
 }
 
-// GetAssetWithResponse request returning *GetAssetResponse
-func (c *ClientWithResponses) GetAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAssetResponse, error) {
-	rsp, err := c.GetAsset(ctx, spaceId, environmentId, resourceId, reqEditors...)
+// GetAllWebhooksWithResponse request returning *GetAllWebhooksResponse
+func (c *ClientWithResponses) GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error) {
+	rsp, err := c.GetAllWebhooks(ctx, spaceId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAssetResponse(rsp)
+	return ParseGetAllWebhooksResponse(rsp)
 }
 
-// UpdateAssetWithBodyWithResponse request with arbitrary body returning *UpdateAssetResponse
-func (c *ClientWithResponses) UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
-	rsp, err := c.UpdateAssetWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, spaceId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAssetResponse(rsp)
+	return ParseCreateWebhookResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
-	rsp, err := c.UpdateAsset(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, spaceId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAssetResponse(rsp)
+	return ParseCreateWebhookResponse(rsp)
 }
 
-// UnarchiveAssetWithResponse request returning *UnarchiveAssetResponse
-func (c *ClientWithResponses) UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error) {
-	rsp, err := c.UnarchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, spaceId, webhookId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnarchiveAssetResponse(rsp)
+	return ParseDeleteWebhookResponse(rsp)
 }
 
-// ArchiveAssetWithResponse request returning *ArchiveAssetResponse
-func (c *ClientWithResponses) ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error) {
-	rsp, err := c.ArchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+// GetWebhookWithResponse request returning *GetWebhookResponse
+func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
+	rsp, err := c.GetWebhook(ctx, spaceId, webhookId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseArchiveAssetResponse(rsp)
+	return ParseGetWebhookResponse(rsp)
 }
 
-// ProcessAssetWithResponse request returning *ProcessAssetResponse
-func (c *ClientWithResponses) ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error) {
-	rsp, err := c.ProcessAsset(ctx, spaceId, environmentId, resourceId, localeCode, reqEditors...)
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, spaceId, webhookId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseProcessAssetResponse(rsp)
+	return ParseUpdateWebhookResponse(rsp)
 }
 
-// UnpublishAssetWithResponse request returning *UnpublishAssetResponse
-func (c *ClientWithResponses) UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error) {
-	rsp, err := c.UnpublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, spaceId, webhookId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnpublishAssetResponse(rsp)
+	return ParseUpdateWebhookResponse(rsp)
 }
 
-// PublishAssetWithResponse request returning *PublishAssetResponse
-func (c *ClientWithResponses) PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error) {
-	rsp, err := c.PublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+// ParseGetAllAppDefinitionsResponse parses an HTTP response from a GetAllAppDefinitionsWithResponse call
+func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinitionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePublishAssetResponse(rsp)
+
+	response := &GetAllAppDefinitionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinitionCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetAllContentTypesWithResponse request returning *GetAllContentTypesResponse
-func (c *ClientWithResponses) GetAllContentTypesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*GetAllContentTypesResponse, error) {
-	rsp, err := c.GetAllContentTypes(ctx, spaceId, environmentId, params, reqEditors...)
+// ParseCreateAppDefinitionResponse parses an HTTP response from a CreateAppDefinitionWithResponse call
+func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllContentTypesResponse(rsp)
+
+	response := &CreateAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreateContentTypeWithBodyWithResponse request with arbitrary body returning *CreateContentTypeResponse
-func (c *ClientWithResponses) CreateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error) {
-	rsp, err := c.CreateContentTypeWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
+// ParseDeleteAppDefinitionResponse parses an HTTP response from a DeleteAppDefinitionWithResponse call
+func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateContentTypeResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContentTypeResponse, error) {
-	rsp, err := c.CreateContentType(ctx, spaceId, environmentId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateContentTypeResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteContentTypeWithResponse request returning *DeleteContentTypeResponse
-func (c *ClientWithResponses) DeleteContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams, reqEditors ...RequestEditorFn) (*DeleteContentTypeResponse, error) {
-	rsp, err := c.DeleteContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
+// ParseGetAppDefinitionResponse parses an HTTP response from a GetAppDefinitionWithResponse call
+func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteContentTypeResponse(rsp)
-}
 
-// GetContentTypeWithResponse request returning *GetContentTypeResponse
-func (c *ClientWithResponses) GetContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetContentTypeResponse, error) {
-	rsp, err := c.GetContentType(ctx, spaceId, environmentId, contentTypeId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetContentTypeResponse(rsp)
-}
 
-// UpdateContentTypeWithBodyWithResponse request with arbitrary body returning *UpdateContentTypeResponse
-func (c *ClientWithResponses) UpdateContentTypeWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error) {
-	rsp, err := c.UpdateContentTypeWithBody(ctx, spaceId, environmentId, contentTypeId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateContentTypeResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateContentTypeParams, body UpdateContentTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContentTypeResponse, error) {
-	rsp, err := c.UpdateContentType(ctx, spaceId, environmentId, contentTypeId, params, body, reqEditors...)
+// ParseUpdateAppDefinitionResponse parses an HTTP response from a UpdateAppDefinitionWithResponse call
+func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateContentTypeResponse(rsp)
-}
 
-// GetEditorInterfaceWithResponse request returning *GetEditorInterfaceResponse
-func (c *ClientWithResponses) GetEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, reqEditors ...RequestEditorFn) (*GetEditorInterfaceResponse, error) {
-	rsp, err := c.GetEditorInterface(ctx, spaceId, environmentId, contentTypeId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetEditorInterfaceResponse(rsp)
-}
 
-// UpdateEditorInterfaceWithBodyWithResponse request with arbitrary body returning *UpdateEditorInterfaceResponse
-func (c *ClientWithResponses) UpdateEditorInterfaceWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error) {
-	rsp, err := c.UpdateEditorInterfaceWithBody(ctx, spaceId, environmentId, contentTypeId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateEditorInterfaceResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateEditorInterfaceWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *UpdateEditorInterfaceParams, body UpdateEditorInterfaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEditorInterfaceResponse, error) {
-	rsp, err := c.UpdateEditorInterface(ctx, spaceId, environmentId, contentTypeId, params, body, reqEditors...)
+// ParseCreateAppActionResponse parses an HTTP response from a CreateAppActionWithResponse call
+func ParseCreateAppActionResponse(rsp *http.Response) (*CreateAppActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEditorInterfaceResponse(rsp)
-}
 
-// DeactivateContentTypeWithResponse request returning *DeactivateContentTypeResponse
-func (c *ClientWithResponses) DeactivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeactivateContentTypeParams, reqEditors ...RequestEditorFn) (*DeactivateContentTypeResponse, error) {
-	rsp, err := c.DeactivateContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateAppActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeactivateContentTypeResponse(rsp)
-}
 
-// ActivateContentTypeWithResponse request returning *ActivateContentTypeResponse
-func (c *ClientWithResponses) ActivateContentTypeWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *ActivateContentTypeParams, reqEditors ...RequestEditorFn) (*ActivateContentTypeResponse, error) {
-	rsp, err := c.ActivateContentType(ctx, spaceId, environmentId, contentTypeId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseActivateContentTypeResponse(rsp)
+
+	return response, nil
 }
 
-// GetAllEntriesWithResponse request returning *GetAllEntriesResponse
-func (c *ClientWithResponses) GetAllEntriesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllEntriesParams, reqEditors ...RequestEditorFn) (*GetAllEntriesResponse, error) {
-	rsp, err := c.GetAllEntries(ctx, spaceId, environmentId, params, reqEditors...)
+// ParseDeleteAppActionResponse parses an HTTP response from a DeleteAppActionWithResponse call
+func ParseDeleteAppActionResponse(rsp *http.Response) (*DeleteAppActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllEntriesResponse(rsp)
-}
 
-// CreateEntryWithBodyWithResponse request with arbitrary body returning *CreateEntryResponse
-func (c *ClientWithResponses) CreateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error) {
-	rsp, err := c.CreateEntryWithBody(ctx, spaceId, environmentId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateEntryResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *CreateEntryParams, body CreateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEntryResponse, error) {
-	rsp, err := c.CreateEntry(ctx, spaceId, environmentId, params, body, reqEditors...)
+// ParseGetAppActionResponse parses an HTTP response from a GetAppActionWithResponse call
+func ParseGetAppActionResponse(rsp *http.Response) (*GetAppActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEntryResponse(rsp)
-}
 
-// DeleteEntryWithResponse request returning *DeleteEntryResponse
-func (c *ClientWithResponses) DeleteEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *DeleteEntryParams, reqEditors ...RequestEditorFn) (*DeleteEntryResponse, error) {
-	rsp, err := c.DeleteEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteEntryResponse(rsp)
-}
 
-// GetEntryWithResponse request returning *GetEntryResponse
-func (c *ClientWithResponses) GetEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*GetEntryResponse, error) {
-	rsp, err := c.GetEntry(ctx, spaceId, environmentId, entryId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetEntryResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateEntryWithBodyWithResponse request with arbitrary body returning *UpdateEntryResponse
-func (c *ClientWithResponses) UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error) {
-	rsp, err := c.UpdateEntryWithBody(ctx, spaceId, environmentId, entryId, params, contentType, body, reqEditors...)
+// ParseUpdateAppActionResponse parses an HTTP response from a UpdateAppActionWithResponse call
+func ParseUpdateAppActionResponse(rsp *http.Response) (*UpdateAppActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateEntryResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error) {
-	rsp, err := c.UpdateEntry(ctx, spaceId, environmentId, entryId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateEntryResponse(rsp)
-}
 
-// UnarchiveEntryWithResponse request returning *UnarchiveEntryResponse
-func (c *ClientWithResponses) UnarchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams, reqEditors ...RequestEditorFn) (*UnarchiveEntryResponse, error) {
-	rsp, err := c.UnarchiveEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUnarchiveEntryResponse(rsp)
+
+	return response, nil
 }
 
-// ArchiveEntryWithResponse request returning *ArchiveEntryResponse
-func (c *ClientWithResponses) ArchiveEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *ArchiveEntryParams, reqEditors ...RequestEditorFn) (*ArchiveEntryResponse, error) {
-	rsp, err := c.ArchiveEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
+// ParseCreateAppBundleResponse parses an HTTP response from a CreateAppBundleWithResponse call
+func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseArchiveEntryResponse(rsp)
-}
 
-// UnpublishEntryWithResponse request returning *UnpublishEntryResponse
-func (c *ClientWithResponses) UnpublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*UnpublishEntryResponse, error) {
-	rsp, err := c.UnpublishEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateAppBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUnpublishEntryResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// PublishEntryWithResponse request returning *PublishEntryResponse
-func (c *ClientWithResponses) PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error) {
-	rsp, err := c.PublishEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
+// ParseDeleteAppBundleResponse parses an HTTP response from a DeleteAppBundleWithResponse call
+func ParseDeleteAppBundleResponse(rsp *http.Response) (*DeleteAppBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePublishEntryResponse(rsp)
+
+	response := &DeleteAppBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// GetAllLocalesWithResponse request returning *GetAllLocalesResponse
-func (c *ClientWithResponses) GetAllLocalesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*GetAllLocalesResponse, error) {
-	rsp, err := c.GetAllLocales(ctx, spaceId, environmentId, params, reqEditors...)
+// ParseGetAppBundleResponse parses an HTTP response from a GetAppBundleWithResponse call
+func ParseGetAppBundleResponse(rsp *http.Response) (*GetAppBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllLocalesResponse(rsp)
-}
 
-// CreateLocaleWithBodyWithResponse request with arbitrary body returning *CreateLocaleResponse
-func (c *ClientWithResponses) CreateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error) {
-	rsp, err := c.CreateLocaleWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateLocaleResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLocaleResponse, error) {
-	rsp, err := c.CreateLocale(ctx, spaceId, environmentId, body, reqEditors...)
+// ParseDeleteAppEventSubscriptionResponse parses an HTTP response from a DeleteAppEventSubscriptionWithResponse call
+func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateLocaleResponse(rsp)
-}
 
-// DeleteLocaleWithResponse request returning *DeleteLocaleResponse
-func (c *ClientWithResponses) DeleteLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*DeleteLocaleResponse, error) {
-	rsp, err := c.DeleteLocale(ctx, spaceId, environmentId, localeId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteLocaleResponse(rsp)
+
+	return response, nil
 }
 
-// GetLocaleWithResponse request returning *GetLocaleResponse
-func (c *ClientWithResponses) GetLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, reqEditors ...RequestEditorFn) (*GetLocaleResponse, error) {
-	rsp, err := c.GetLocale(ctx, spaceId, environmentId, localeId, reqEditors...)
+// ParseGetAppEventSubscriptionResponse parses an HTTP response from a GetAppEventSubscriptionWithResponse call
+func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLocaleResponse(rsp)
-}
 
-// UpdateLocaleWithBodyWithResponse request with arbitrary body returning *UpdateLocaleResponse
-func (c *ClientWithResponses) UpdateLocaleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error) {
-	rsp, err := c.UpdateLocaleWithBody(ctx, spaceId, environmentId, localeId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateLocaleResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error) {
-	rsp, err := c.UpdateLocale(ctx, spaceId, environmentId, localeId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateLocaleResponse(rsp)
+
+	return response, nil
 }
 
-// GetAllPreviewApiKeysWithResponse request returning *GetAllPreviewApiKeysResponse
-func (c *ClientWithResponses) GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error) {
-	rsp, err := c.GetAllPreviewApiKeys(ctx, spaceId, params, reqEditors...)
+// ParseUpdateAppEventSubscriptionResponse parses an HTTP response from a UpdateAppEventSubscriptionWithResponse call
+func ParseUpdateAppEventSubscriptionResponse(rsp *http.Response) (*UpdateAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllPreviewApiKeysResponse(rsp)
-}
 
-// GetPreviewApiKeyWithResponse request returning *GetPreviewApiKeyResponse
-func (c *ClientWithResponses) GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error) {
-	rsp, err := c.GetPreviewApiKey(ctx, spaceId, resourceId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetPreviewApiKeyResponse(rsp)
-}
 
-// CreatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *CreatePreviewEnvironmentResponse
-func (c *ClientWithResponses) CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
-	rsp, err := c.CreatePreviewEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseCreatePreviewEnvironmentResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
-	rsp, err := c.CreatePreviewEnvironment(ctx, spaceId, body, reqEditors...)
+// ParseCreateAppKeyResponse parses an HTTP response from a CreateAppKeyWithResponse call
+func ParseCreateAppKeyResponse(rsp *http.Response) (*CreateAppKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePreviewEnvironmentResponse(rsp)
-}
 
-// DeletePreviewEnvironmentWithResponse request returning *DeletePreviewEnvironmentResponse
-func (c *ClientWithResponses) DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error) {
-	rsp, err := c.DeletePreviewEnvironment(ctx, spaceId, resourceId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateAppKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePreviewEnvironmentResponse(rsp)
-}
 
-// GetPreviewEnvironmentWithResponse request returning *GetPreviewEnvironmentResponse
-func (c *ClientWithResponses) GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error) {
-	rsp, err := c.GetPreviewEnvironment(ctx, spaceId, resourceId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseGetPreviewEnvironmentResponse(rsp)
+
+	return response, nil
 }
 
-// UpdatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdatePreviewEnvironmentResponse
-func (c *ClientWithResponses) UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
-	rsp, err := c.UpdatePreviewEnvironmentWithBody(ctx, spaceId, resourceId, params, contentType, body, reqEditors...)
+// ParseDeleteAppKeyResponse parses an HTTP response from a DeleteAppKeyWithResponse call
+func ParseDeleteAppKeyResponse(rsp *http.Response) (*DeleteAppKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePreviewEnvironmentResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
-	rsp, err := c.UpdatePreviewEnvironment(ctx, spaceId, resourceId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePreviewEnvironmentResponse(rsp)
+
+	return response, nil
 }
 
-// GetAllRolesWithResponse request returning *GetAllRolesResponse
-func (c *ClientWithResponses) GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error) {
-	rsp, err := c.GetAllRoles(ctx, spaceId, params, reqEditors...)
+// ParseGetAppKeyResponse parses an HTTP response from a GetAppKeyWithResponse call
+func ParseGetAppKeyResponse(rsp *http.Response) (*GetAppKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllRolesResponse(rsp)
-}
 
-// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
-func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRoleWithBody(ctx, spaceId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateRoleResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRole(ctx, spaceId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateRoleResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteRoleWithResponse request returning *DeleteRoleResponse
-func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
-	rsp, err := c.DeleteRole(ctx, spaceId, roleId, params, reqEditors...)
+// ParseDeleteAppSigningSecretResponse parses an HTTP response from a DeleteAppSigningSecretWithResponse call
+func ParseDeleteAppSigningSecretResponse(rsp *http.Response) (*DeleteAppSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteRoleResponse(rsp)
-}
 
-// GetRoleWithResponse request returning *GetRoleResponse
-func (c *ClientWithResponses) GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
-	rsp, err := c.GetRole(ctx, spaceId, roleId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetRoleResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
-func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
-	rsp, err := c.UpdateRoleWithBody(ctx, spaceId, roleId, params, contentType, body, reqEditors...)
+// ParseGetAppSigningSecretResponse parses an HTTP response from a GetAppSigningSecretWithResponse call
+func ParseGetAppSigningSecretResponse(rsp *http.Response) (*GetAppSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateRoleResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
-	rsp, err := c.UpdateRole(ctx, spaceId, roleId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateRoleResponse(rsp)
-}
 
-// GetAllWebhooksWithResponse request returning *GetAllWebhooksResponse
-func (c *ClientWithResponses) GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error) {
-	rsp, err := c.GetAllWebhooks(ctx, spaceId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppSigningSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetAllWebhooksResponse(rsp)
+
+	return response, nil
 }
 
-// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
-func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
-	rsp, err := c.CreateWebhookWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// ParseUpdateAppSigningSecretResponse parses an HTTP response from a UpdateAppSigningSecretWithResponse call
+func ParseUpdateAppSigningSecretResponse(rsp *http.Response) (*UpdateAppSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateWebhookResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
-	rsp, err := c.CreateWebhook(ctx, spaceId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateWebhookResponse(rsp)
-}
 
-// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
-func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
-	rsp, err := c.DeleteWebhook(ctx, spaceId, webhookId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppSigningSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeleteWebhookResponse(rsp)
+
+	return response, nil
 }
 
-// GetWebhookWithResponse request returning *GetWebhookResponse
-func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
-	rsp, err := c.GetWebhook(ctx, spaceId, webhookId, reqEditors...)
+// ParseUploadAppResponse parses an HTTP response from a UploadAppWithResponse call
+func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWebhookResponse(rsp)
-}
 
-// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
-func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
-	rsp, err := c.UpdateWebhookWithBody(ctx, spaceId, webhookId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UploadAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateWebhookResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
-	rsp, err := c.UpdateWebhook(ctx, spaceId, webhookId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Sys SystemPropertiesBase `json:"sys"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseUpdateWebhookResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetAllAppDefinitionsResponse parses an HTTP response from a GetAllAppDefinitionsWithResponse call
-func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinitionsResponse, error) {
+// ParseGetAllSpacesResponse parses an HTTP response from a GetAllSpacesWithResponse call
+func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAppDefinitionsResponse{
+	response := &GetAllSpacesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinitionCollection
+		var dest SpaceCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11981,22 +16100,22 @@ func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinition
 	return response, nil
 }
 
-// ParseCreateAppDefinitionResponse parses an HTTP response from a CreateAppDefinitionWithResponse call
-func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionResponse, error) {
+// ParseCreateSpaceResponse parses an HTTP response from a CreateSpaceWithResponse call
+func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppDefinitionResponse{
+	response := &CreateSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12007,15 +16126,15 @@ func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionR
 	return response, nil
 }
 
-// ParseDeleteAppDefinitionResponse parses an HTTP response from a DeleteAppDefinitionWithResponse call
-func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionResponse, error) {
+// ParseDeleteSpaceResponse parses an HTTP response from a DeleteSpaceWithResponse call
+func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppDefinitionResponse{
+	response := &DeleteSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12023,22 +16142,22 @@ func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionR
 	return response, nil
 }
 
-// ParseGetAppDefinitionResponse parses an HTTP response from a GetAppDefinitionWithResponse call
-func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionResponse, error) {
+// ParseGetSpaceResponse parses an HTTP response from a GetSpaceWithResponse call
+func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppDefinitionResponse{
+	response := &GetSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12049,22 +16168,22 @@ func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionRespons
 	return response, nil
 }
 
-// ParseUpdateAppDefinitionResponse parses an HTTP response from a UpdateAppDefinitionWithResponse call
-func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionResponse, error) {
+// ParseUpdateSpaceResponse parses an HTTP response from a UpdateSpaceWithResponse call
+func ParseUpdateSpaceResponse(rsp *http.Response) (*UpdateSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppDefinitionResponse{
+	response := &UpdateSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12075,24 +16194,48 @@ func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionR
 	return response, nil
 }
 
-// ParseCreateAppBundleResponse parses an HTTP response from a CreateAppBundleWithResponse call
-func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse, error) {
+// ParseGetAllApiKeysResponse parses an HTTP response from a GetAllApiKeysWithResponse call
+func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppBundleResponse{
+	response := &GetAllApiKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Sys SystemPropertiesBase `json:"sys"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKeyCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12103,15 +16246,15 @@ func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse,
 	return response, nil
 }
 
-// ParseDeleteAppEventSubscriptionResponse parses an HTTP response from a DeleteAppEventSubscriptionWithResponse call
-func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEventSubscriptionResponse, error) {
+// ParseDeleteApiKeyResponse parses an HTTP response from a DeleteApiKeyWithResponse call
+func ParseDeleteApiKeyResponse(rsp *http.Response) (*DeleteApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppEventSubscriptionResponse{
+	response := &DeleteApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12119,22 +16262,22 @@ func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEven
 	return response, nil
 }
 
-// ParseGetAppEventSubscriptionResponse parses an HTTP response from a GetAppEventSubscriptionWithResponse call
-func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubscriptionResponse, error) {
+// ParseGetApiKeyResponse parses an HTTP response from a GetApiKeyWithResponse call
+func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppEventSubscriptionResponse{
+	response := &GetApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppEventSubscription
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12145,57 +16288,123 @@ func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubsc
 	return response, nil
 }
 
-// ParseUpdateAppEventSubscriptionResponse parses an HTTP response from a UpdateAppEventSubscriptionWithResponse call
-func ParseUpdateAppEventSubscriptionResponse(rsp *http.Response) (*UpdateAppEventSubscriptionResponse, error) {
+// ParseUpdateApiKeyResponse parses an HTTP response from a UpdateApiKeyWithResponse call
+func ParseUpdateApiKeyResponse(rsp *http.Response) (*UpdateApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppEventSubscriptionResponse{
+	response := &UpdateApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppEventSubscription
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppEventSubscription
+	}
+
+	return response, nil
+}
+
+// ParseGetAllEnvironmentAliasesResponse parses an HTTP response from a GetAllEnvironmentAliasesWithResponse call
+func ParseGetAllEnvironmentAliasesResponse(rsp *http.Response) (*GetAllEnvironmentAliasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllEnvironmentAliasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentAliasCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUploadAppResponse parses an HTTP response from a UploadAppWithResponse call
-func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
+// ParseDeleteEnvironmentAliasResponse parses an HTTP response from a DeleteEnvironmentAliasWithResponse call
+func ParseDeleteEnvironmentAliasResponse(rsp *http.Response) (*DeleteEnvironmentAliasResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadAppResponse{
+	response := &DeleteEnvironmentAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentAliasResponse parses an HTTP response from a GetEnvironmentAliasWithResponse call
+func ParseGetEnvironmentAliasResponse(rsp *http.Response) (*GetEnvironmentAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentAliasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Sys SystemPropertiesBase `json:"sys"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpsertEnvironmentAliasResponse parses an HTTP response from a UpsertEnvironmentAliasWithResponse call
+func ParseUpsertEnvironmentAliasResponse(rsp *http.Response) (*UpsertEnvironmentAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpsertEnvironmentAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EnvironmentAlias
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12206,22 +16415,22 @@ func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
 	return response, nil
 }
 
-// ParseGetAllSpacesResponse parses an HTTP response from a GetAllSpacesWithResponse call
-func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error) {
+// ParseGetAllEnvironmentsResponse parses an HTTP response from a GetAllEnvironmentsWithResponse call
+func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllSpacesResponse{
+	response := &GetAllEnvironmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SpaceCollection
+		var dest EnvironmentCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12232,22 +16441,22 @@ func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error
 	return response, nil
 }
 
-// ParseCreateSpaceResponse parses an HTTP response from a CreateSpaceWithResponse call
-func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error) {
+// ParseCreateEnvironmentResponse parses an HTTP response from a CreateEnvironmentWithResponse call
+func ParseCreateEnvironmentResponse(rsp *http.Response) (*CreateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSpaceResponse{
+	response := &CreateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Space
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12258,15 +16467,15 @@ func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error)
 	return response, nil
 }
 
-// ParseDeleteSpaceResponse parses an HTTP response from a DeleteSpaceWithResponse call
-func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error) {
+// ParseDeleteEnvironmentResponse parses an HTTP response from a DeleteEnvironmentWithResponse call
+func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteSpaceResponse{
+	response := &DeleteEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12274,22 +16483,22 @@ func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error)
 	return response, nil
 }
 
-// ParseGetSpaceResponse parses an HTTP response from a GetSpaceWithResponse call
-func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
+// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
+func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSpaceResponse{
+	response := &GetEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Space
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12300,22 +16509,22 @@ func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateSpaceResponse parses an HTTP response from a UpdateSpaceWithResponse call
-func ParseUpdateSpaceResponse(rsp *http.Response) (*UpdateSpaceResponse, error) {
+// ParseUpdateEnvironmentResponse parses an HTTP response from a UpdateEnvironmentWithResponse call
+func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSpaceResponse{
+	response := &UpdateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Space
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12326,22 +16535,22 @@ func ParseUpdateSpaceResponse(rsp *http.Response) (*UpdateSpaceResponse, error)
 	return response, nil
 }
 
-// ParseGetAllApiKeysResponse parses an HTTP response from a GetAllApiKeysWithResponse call
-func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, error) {
+// ParseGetAllAppInstallationsResponse parses an HTTP response from a GetAllAppInstallationsWithResponse call
+func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstallationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllApiKeysResponse{
+	response := &GetAllAppInstallationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKeyCollection
+		var dest AppInstallationCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12352,90 +16561,97 @@ func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, err
 	return response, nil
 }
 
-// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
-func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+// ParseDeleteAppInstallationResponse parses an HTTP response from a DeleteAppInstallationWithResponse call
+func ParseDeleteAppInstallationResponse(rsp *http.Response) (*DeleteAppInstallationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateApiKeyResponse{
+	response := &DeleteAppInstallationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ApiKey
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseDeleteApiKeyResponse parses an HTTP response from a DeleteApiKeyWithResponse call
-func ParseDeleteApiKeyResponse(rsp *http.Response) (*DeleteApiKeyResponse, error) {
+// ParseGetAppInstallationResponse parses an HTTP response from a GetAppInstallationWithResponse call
+func ParseGetAppInstallationResponse(rsp *http.Response) (*GetAppInstallationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiKeyResponse{
+	response := &GetAppInstallationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppInstallation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetApiKeyResponse parses an HTTP response from a GetApiKeyWithResponse call
-func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
+// ParseUpsertAppInstallationResponse parses an HTTP response from a UpsertAppInstallationWithResponse call
+func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiKeyResponse{
+	response := &UpsertAppInstallationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKey
+		var dest AppInstallation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppInstallation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateApiKeyResponse parses an HTTP response from a UpdateApiKeyWithResponse call
-func ParseUpdateApiKeyResponse(rsp *http.Response) (*UpdateApiKeyResponse, error) {
+// ParseGetAllAssetsResponse parses an HTTP response from a GetAllAssetsWithResponse call
+func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateApiKeyResponse{
+	response := &GetAllAssetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKey
+		var dest AssetCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12446,41 +16662,41 @@ func ParseUpdateApiKeyResponse(rsp *http.Response) (*UpdateApiKeyResponse, error
 	return response, nil
 }
 
-// ParseGetAllEnvironmentAliasesResponse parses an HTTP response from a GetAllEnvironmentAliasesWithResponse call
-func ParseGetAllEnvironmentAliasesResponse(rsp *http.Response) (*GetAllEnvironmentAliasesResponse, error) {
+// ParseCreateAssetResponse parses an HTTP response from a CreateAssetWithResponse call
+func ParseCreateAssetResponse(rsp *http.Response) (*CreateAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllEnvironmentAliasesResponse{
+	response := &CreateAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentAliasCollection
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteEnvironmentAliasResponse parses an HTTP response from a DeleteEnvironmentAliasWithResponse call
-func ParseDeleteEnvironmentAliasResponse(rsp *http.Response) (*DeleteEnvironmentAliasResponse, error) {
+// ParseDeleteAssetResponse parses an HTTP response from a DeleteAssetWithResponse call
+func ParseDeleteAssetResponse(rsp *http.Response) (*DeleteAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentAliasResponse{
+	response := &DeleteAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12488,22 +16704,22 @@ func ParseDeleteEnvironmentAliasResponse(rsp *http.Response) (*DeleteEnvironment
 	return response, nil
 }
 
-// ParseGetEnvironmentAliasResponse parses an HTTP response from a GetEnvironmentAliasWithResponse call
-func ParseGetEnvironmentAliasResponse(rsp *http.Response) (*GetEnvironmentAliasResponse, error) {
+// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
+func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentAliasResponse{
+	response := &GetAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentAlias
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12514,29 +16730,29 @@ func ParseGetEnvironmentAliasResponse(rsp *http.Response) (*GetEnvironmentAliasR
 	return response, nil
 }
 
-// ParseUpsertEnvironmentAliasResponse parses an HTTP response from a UpsertEnvironmentAliasWithResponse call
-func ParseUpsertEnvironmentAliasResponse(rsp *http.Response) (*UpsertEnvironmentAliasResponse, error) {
+// ParseUpdateAssetResponse parses an HTTP response from a UpdateAssetWithResponse call
+func ParseUpdateAssetResponse(rsp *http.Response) (*UpdateAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpsertEnvironmentAliasResponse{
+	response := &UpdateAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentAlias
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest EnvironmentAlias
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12547,22 +16763,22 @@ func ParseUpsertEnvironmentAliasResponse(rsp *http.Response) (*UpsertEnvironment
 	return response, nil
 }
 
-// ParseGetAllEnvironmentsResponse parses an HTTP response from a GetAllEnvironmentsWithResponse call
-func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsResponse, error) {
+// ParseUnarchiveAssetResponse parses an HTTP response from a UnarchiveAssetWithResponse call
+func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllEnvironmentsResponse{
+	response := &UnarchiveAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentCollection
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12573,41 +16789,41 @@ func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsRes
 	return response, nil
 }
 
-// ParseCreateEnvironmentResponse parses an HTTP response from a CreateEnvironmentWithResponse call
-func ParseCreateEnvironmentResponse(rsp *http.Response) (*CreateEnvironmentResponse, error) {
+// ParseArchiveAssetResponse parses an HTTP response from a ArchiveAssetWithResponse call
+func ParseArchiveAssetResponse(rsp *http.Response) (*ArchiveAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEnvironmentResponse{
+	response := &ArchiveAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Environment
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteEnvironmentResponse parses an HTTP response from a DeleteEnvironmentWithResponse call
-func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentResponse, error) {
+// ParseProcessAssetResponse parses an HTTP response from a ProcessAssetWithResponse call
+func ParseProcessAssetResponse(rsp *http.Response) (*ProcessAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentResponse{
+	response := &ProcessAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12615,22 +16831,22 @@ func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentRespo
 	return response, nil
 }
 
-// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
-func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
+// ParseUnpublishAssetResponse parses an HTTP response from a UnpublishAssetWithResponse call
+func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentResponse{
+	response := &UnpublishAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12641,22 +16857,22 @@ func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, e
 	return response, nil
 }
 
-// ParseUpdateEnvironmentResponse parses an HTTP response from a UpdateEnvironmentWithResponse call
-func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentResponse, error) {
+// ParsePublishAssetResponse parses an HTTP response from a PublishAssetWithResponse call
+func ParsePublishAssetResponse(rsp *http.Response) (*PublishAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEnvironmentResponse{
+	response := &PublishAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12667,22 +16883,22 @@ func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentRespo
 	return response, nil
 }
 
-// ParseGetAllAppInstallationsResponse parses an HTTP response from a GetAllAppInstallationsWithResponse call
-func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstallationsResponse, error) {
+// ParseGetAllContentTypesResponse parses an HTTP response from a GetAllContentTypesWithResponse call
+func ParseGetAllContentTypesResponse(rsp *http.Response) (*GetAllContentTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAppInstallationsResponse{
+	response := &GetAllContentTypesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallationCollection
+		var dest ContentTypeCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12693,15 +16909,41 @@ func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstalla
 	return response, nil
 }
 
-// ParseDeleteAppInstallationResponse parses an HTTP response from a DeleteAppInstallationWithResponse call
-func ParseDeleteAppInstallationResponse(rsp *http.Response) (*DeleteAppInstallationResponse, error) {
+// ParseCreateContentTypeResponse parses an HTTP response from a CreateContentTypeWithResponse call
+func ParseCreateContentTypeResponse(rsp *http.Response) (*CreateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppInstallationResponse{
+	response := &CreateContentTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ContentType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteContentTypeResponse parses an HTTP response from a DeleteContentTypeWithResponse call
+func ParseDeleteContentTypeResponse(rsp *http.Response) (*DeleteContentTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12709,22 +16951,22 @@ func ParseDeleteAppInstallationResponse(rsp *http.Response) (*DeleteAppInstallat
 	return response, nil
 }
 
-// ParseGetAppInstallationResponse parses an HTTP response from a GetAppInstallationWithResponse call
-func ParseGetAppInstallationResponse(rsp *http.Response) (*GetAppInstallationResponse, error) {
+// ParseGetContentTypeResponse parses an HTTP response from a GetContentTypeWithResponse call
+func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppInstallationResponse{
+	response := &GetContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallation
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12735,29 +16977,29 @@ func ParseGetAppInstallationResponse(rsp *http.Response) (*GetAppInstallationRes
 	return response, nil
 }
 
-// ParseUpsertAppInstallationResponse parses an HTTP response from a UpsertAppInstallationWithResponse call
-func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallationResponse, error) {
+// ParseUpdateContentTypeResponse parses an HTTP response from a UpdateContentTypeWithResponse call
+func ParseUpdateContentTypeResponse(rsp *http.Response) (*UpdateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpsertAppInstallationResponse{
+	response := &UpdateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallation
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppInstallation
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12768,22 +17010,22 @@ func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallat
 	return response, nil
 }
 
-// ParseGetAllAssetsResponse parses an HTTP response from a GetAllAssetsWithResponse call
-func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error) {
+// ParseGetEditorInterfaceResponse parses an HTTP response from a GetEditorInterfaceWithResponse call
+func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAssetsResponse{
+	response := &GetEditorInterfaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AssetCollection
+		var dest EditorInterface
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12794,64 +17036,48 @@ func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error
 	return response, nil
 }
 
-// ParseCreateAssetResponse parses an HTTP response from a CreateAssetWithResponse call
-func ParseCreateAssetResponse(rsp *http.Response) (*CreateAssetResponse, error) {
+// ParseUpdateEditorInterfaceResponse parses an HTTP response from a UpdateEditorInterfaceWithResponse call
+func ParseUpdateEditorInterfaceResponse(rsp *http.Response) (*UpdateEditorInterfaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAssetResponse{
+	response := &UpdateEditorInterfaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Asset
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EditorInterface
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseDeleteAssetResponse parses an HTTP response from a DeleteAssetWithResponse call
-func ParseDeleteAssetResponse(rsp *http.Response) (*DeleteAssetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON200 = &dest
 
-	response := &DeleteAssetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
-func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
+// ParseDeactivateContentTypeResponse parses an HTTP response from a DeactivateContentTypeWithResponse call
+func ParseDeactivateContentTypeResponse(rsp *http.Response) (*DeactivateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAssetResponse{
+	response := &DeactivateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12862,55 +17088,48 @@ func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateAssetResponse parses an HTTP response from a UpdateAssetWithResponse call
-func ParseUpdateAssetResponse(rsp *http.Response) (*UpdateAssetResponse, error) {
+// ParseActivateContentTypeResponse parses an HTTP response from a ActivateContentTypeWithResponse call
+func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAssetResponse{
+	response := &ActivateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUnarchiveAssetResponse parses an HTTP response from a UnarchiveAssetWithResponse call
-func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, error) {
+// ParseGetAllEntriesResponse parses an HTTP response from a GetAllEntriesWithResponse call
+func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnarchiveAssetResponse{
+	response := &GetAllEntriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest EntryCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12921,41 +17140,41 @@ func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, e
 	return response, nil
 }
 
-// ParseArchiveAssetResponse parses an HTTP response from a ArchiveAssetWithResponse call
-func ParseArchiveAssetResponse(rsp *http.Response) (*ArchiveAssetResponse, error) {
+// ParseCreateEntryResponse parses an HTTP response from a CreateEntryWithResponse call
+func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveAssetResponse{
+	response := &CreateEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseProcessAssetResponse parses an HTTP response from a ProcessAssetWithResponse call
-func ParseProcessAssetResponse(rsp *http.Response) (*ProcessAssetResponse, error) {
+// ParseDeleteEntryResponse parses an HTTP response from a DeleteEntryWithResponse call
+func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ProcessAssetResponse{
+	response := &DeleteEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12963,22 +17182,22 @@ func ParseProcessAssetResponse(rsp *http.Response) (*ProcessAssetResponse, error
 	return response, nil
 }
 
-// ParseUnpublishAssetResponse parses an HTTP response from a UnpublishAssetWithResponse call
-func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, error) {
+// ParseGetEntryResponse parses an HTTP response from a GetEntryWithResponse call
+func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnpublishAssetResponse{
+	response := &GetEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12989,48 +17208,55 @@ func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, e
 	return response, nil
 }
 
-// ParsePublishAssetResponse parses an HTTP response from a PublishAssetWithResponse call
-func ParsePublishAssetResponse(rsp *http.Response) (*PublishAssetResponse, error) {
+// ParseUpdateEntryResponse parses an HTTP response from a UpdateEntryWithResponse call
+func ParseUpdateEntryResponse(rsp *http.Response) (*UpdateEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PublishAssetResponse{
+	response := &UpdateEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Entry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAllContentTypesResponse parses an HTTP response from a GetAllContentTypesWithResponse call
-func ParseGetAllContentTypesResponse(rsp *http.Response) (*GetAllContentTypesResponse, error) {
+// ParseUnarchiveEntryResponse parses an HTTP response from a UnarchiveEntryWithResponse call
+func ParseUnarchiveEntryResponse(rsp *http.Response) (*UnarchiveEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllContentTypesResponse{
+	response := &UnarchiveEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentTypeCollection
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13041,64 +17267,48 @@ func ParseGetAllContentTypesResponse(rsp *http.Response) (*GetAllContentTypesRes
 	return response, nil
 }
 
-// ParseCreateContentTypeResponse parses an HTTP response from a CreateContentTypeWithResponse call
-func ParseCreateContentTypeResponse(rsp *http.Response) (*CreateContentTypeResponse, error) {
+// ParseArchiveEntryResponse parses an HTTP response from a ArchiveEntryWithResponse call
+func ParseArchiveEntryResponse(rsp *http.Response) (*ArchiveEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateContentTypeResponse{
+	response := &ArchiveEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ContentType
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseDeleteContentTypeResponse parses an HTTP response from a DeleteContentTypeWithResponse call
-func ParseDeleteContentTypeResponse(rsp *http.Response) (*DeleteContentTypeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON200 = &dest
 
-	response := &DeleteContentTypeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetContentTypeResponse parses an HTTP response from a GetContentTypeWithResponse call
-func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, error) {
+// ParseUnpublishEntryResponse parses an HTTP response from a UnpublishEntryWithResponse call
+func ParseUnpublishEntryResponse(rsp *http.Response) (*UnpublishEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContentTypeResponse{
+	response := &UnpublishEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13109,55 +17319,48 @@ func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, e
 	return response, nil
 }
 
-// ParseUpdateContentTypeResponse parses an HTTP response from a UpdateContentTypeWithResponse call
-func ParseUpdateContentTypeResponse(rsp *http.Response) (*UpdateContentTypeResponse, error) {
+// ParsePublishEntryResponse parses an HTTP response from a PublishEntryWithResponse call
+func ParsePublishEntryResponse(rsp *http.Response) (*PublishEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateContentTypeResponse{
+	response := &PublishEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ContentType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetEditorInterfaceResponse parses an HTTP response from a GetEditorInterfaceWithResponse call
-func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceResponse, error) {
+// ParseGetAllLocalesResponse parses an HTTP response from a GetAllLocalesWithResponse call
+func ParseGetAllLocalesResponse(rsp *http.Response) (*GetAllLocalesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEditorInterfaceResponse{
+	response := &GetAllLocalesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EditorInterface
+		var dest LocaleCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13168,74 +17371,64 @@ func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceRes
 	return response, nil
 }
 
-// ParseUpdateEditorInterfaceResponse parses an HTTP response from a UpdateEditorInterfaceWithResponse call
-func ParseUpdateEditorInterfaceResponse(rsp *http.Response) (*UpdateEditorInterfaceResponse, error) {
+// ParseCreateLocaleResponse parses an HTTP response from a CreateLocaleWithResponse call
+func ParseCreateLocaleResponse(rsp *http.Response) (*CreateLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEditorInterfaceResponse{
+	response := &CreateLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EditorInterface
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeactivateContentTypeResponse parses an HTTP response from a DeactivateContentTypeWithResponse call
-func ParseDeactivateContentTypeResponse(rsp *http.Response) (*DeactivateContentTypeResponse, error) {
+// ParseDeleteLocaleResponse parses an HTTP response from a DeleteLocaleWithResponse call
+func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeactivateContentTypeResponse{
+	response := &DeleteLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseActivateContentTypeResponse parses an HTTP response from a ActivateContentTypeWithResponse call
-func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeResponse, error) {
+// ParseGetLocaleResponse parses an HTTP response from a GetLocaleWithResponse call
+func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ActivateContentTypeResponse{
+	response := &GetLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13246,22 +17439,22 @@ func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeR
 	return response, nil
 }
 
-// ParseGetAllEntriesResponse parses an HTTP response from a GetAllEntriesWithResponse call
-func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, error) {
+// ParseUpdateLocaleResponse parses an HTTP response from a UpdateLocaleWithResponse call
+func ParseUpdateLocaleResponse(rsp *http.Response) (*UpdateLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllEntriesResponse{
+	response := &UpdateLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EntryCollection
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13272,22 +17465,22 @@ func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, err
 	return response, nil
 }
 
-// ParseCreateEntryResponse parses an HTTP response from a CreateEntryWithResponse call
-func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error) {
+// ParseCreateReleaseResponse parses an HTTP response from a CreateReleaseWithResponse call
+func ParseCreateReleaseResponse(rsp *http.Response) (*CreateReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEntryResponse{
+	response := &CreateReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Entry
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13298,15 +17491,15 @@ func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error)
 	return response, nil
 }
 
-// ParseDeleteEntryResponse parses an HTTP response from a DeleteEntryWithResponse call
-func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error) {
+// ParseDeleteReleaseResponse parses an HTTP response from a DeleteReleaseWithResponse call
+func ParseDeleteReleaseResponse(rsp *http.Response) (*DeleteReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEntryResponse{
+	response := &DeleteReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -13314,22 +17507,22 @@ func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error)
 	return response, nil
 }
 
-// ParseGetEntryResponse parses an HTTP response from a GetEntryWithResponse call
-func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
+// ParseGetReleaseResponse parses an HTTP response from a GetReleaseWithResponse call
+func ParseGetReleaseResponse(rsp *http.Response) (*GetReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEntryResponse{
+	response := &GetReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13340,159 +17533,194 @@ func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateEntryResponse parses an HTTP response from a UpdateEntryWithResponse call
-func ParseUpdateEntryResponse(rsp *http.Response) (*UpdateEntryResponse, error) {
+// ParseUpdateReleaseResponse parses an HTTP response from a UpdateReleaseWithResponse call
+func ParseUpdateReleaseResponse(rsp *http.Response) (*UpdateReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEntryResponse{
+	response := &UpdateReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Entry
+	}
+
+	return response, nil
+}
+
+// ParseGetReleaseActionResponse parses an HTTP response from a GetReleaseActionWithResponse call
+func ParseGetReleaseActionResponse(rsp *http.Response) (*GetReleaseActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReleaseActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUnarchiveEntryResponse parses an HTTP response from a UnarchiveEntryWithResponse call
-func ParseUnarchiveEntryResponse(rsp *http.Response) (*UnarchiveEntryResponse, error) {
+// ParseUnpublishReleaseResponse parses an HTTP response from a UnpublishReleaseWithResponse call
+func ParseUnpublishReleaseResponse(rsp *http.Response) (*UnpublishReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnarchiveEntryResponse{
+	response := &UnpublishReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseArchiveEntryResponse parses an HTTP response from a ArchiveEntryWithResponse call
-func ParseArchiveEntryResponse(rsp *http.Response) (*ArchiveEntryResponse, error) {
+// ParsePublishReleaseResponse parses an HTTP response from a PublishReleaseWithResponse call
+func ParsePublishReleaseResponse(rsp *http.Response) (*PublishReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveEntryResponse{
+	response := &PublishReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUnpublishEntryResponse parses an HTTP response from a UnpublishEntryWithResponse call
-func ParseUnpublishEntryResponse(rsp *http.Response) (*UnpublishEntryResponse, error) {
+// ParseValidateReleaseResponse parses an HTTP response from a ValidateReleaseWithResponse call
+func ParseValidateReleaseResponse(rsp *http.Response) (*ValidateReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnpublishEntryResponse{
+	response := &ValidateReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePublishEntryResponse parses an HTTP response from a PublishEntryWithResponse call
-func ParsePublishEntryResponse(rsp *http.Response) (*PublishEntryResponse, error) {
+// ParseCreateUploadResponse parses an HTTP response from a CreateUploadWithResponse call
+func ParseCreateUploadResponse(rsp *http.Response) (*CreateUploadResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PublishEntryResponse{
+	response := &CreateUploadResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Upload
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAllLocalesResponse parses an HTTP response from a GetAllLocalesWithResponse call
-func ParseGetAllLocalesResponse(rsp *http.Response) (*GetAllLocalesResponse, error) {
+// ParseDeleteUploadResponse parses an HTTP response from a DeleteUploadWithResponse call
+func ParseDeleteUploadResponse(rsp *http.Response) (*DeleteUploadResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllLocalesResponse{
+	response := &DeleteUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadResponse parses an HTTP response from a GetUploadWithResponse call
+func ParseGetUploadResponse(rsp *http.Response) (*GetUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LocaleCollection
+		var dest Upload
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13503,22 +17731,22 @@ func ParseGetAllLocalesResponse(rsp *http.Response) (*GetAllLocalesResponse, err
 	return response, nil
 }
 
-// ParseCreateLocaleResponse parses an HTTP response from a CreateLocaleWithResponse call
-func ParseCreateLocaleResponse(rsp *http.Response) (*CreateLocaleResponse, error) {
+// ParseCreateWorkflowDefinitionResponse parses an HTTP response from a CreateWorkflowDefinitionWithResponse call
+func ParseCreateWorkflowDefinitionResponse(rsp *http.Response) (*CreateWorkflowDefinitionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateLocaleResponse{
+	response := &CreateWorkflowDefinitionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Locale
+		var dest WorkflowDefinition
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13529,15 +17757,15 @@ func ParseCreateLocaleResponse(rsp *http.Response) (*CreateLocaleResponse, error
 	return response, nil
 }
 
-// ParseDeleteLocaleResponse parses an HTTP response from a DeleteLocaleWithResponse call
-func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error) {
+// ParseDeleteWorkflowDefinitionResponse parses an HTTP response from a DeleteWorkflowDefinitionWithResponse call
+func ParseDeleteWorkflowDefinitionResponse(rsp *http.Response) (*DeleteWorkflowDefinitionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteLocaleResponse{
+	response := &DeleteWorkflowDefinitionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -13545,22 +17773,22 @@ func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error
 	return response, nil
 }
 
-// ParseGetLocaleResponse parses an HTTP response from a GetLocaleWithResponse call
-func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
+// ParseGetWorkflowDefinitionResponse parses an HTTP response from a GetWorkflowDefinitionWithResponse call
+func ParseGetWorkflowDefinitionResponse(rsp *http.Response) (*GetWorkflowDefinitionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLocaleResponse{
+	response := &GetWorkflowDefinitionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Locale
+		var dest WorkflowDefinition
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13571,22 +17799,22 @@ func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateLocaleResponse parses an HTTP response from a UpdateLocaleWithResponse call
-func ParseUpdateLocaleResponse(rsp *http.Response) (*UpdateLocaleResponse, error) {
+// ParseUpdateWorkflowDefinitionResponse parses an HTTP response from a UpdateWorkflowDefinitionWithResponse call
+func ParseUpdateWorkflowDefinitionResponse(rsp *http.Response) (*UpdateWorkflowDefinitionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateLocaleResponse{
+	response := &UpdateWorkflowDefinitionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Locale
+		var dest WorkflowDefinition
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
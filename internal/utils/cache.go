@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"sync"
+)
+
+// Cache holds values that are fetched once for each run of the provider, like
+// the content types that entries are validated against during a plan.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry holds the value of a single key. Its lock is held while the value
+// is fetched, so a key is fetched once without blocking the other keys.
+type cacheEntry struct {
+	mu     sync.Mutex
+	value  any
+	cached bool
+}
+
+func NewCache() *Cache {
+	return &Cache{entries: map[string]*cacheEntry{}}
+}
+
+// entry returns the entry of the key, creating it when there is none
+func (c *Cache) entry(key string) *cacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cacheEntry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// Cached returns the value that is cached for the key, or fetches and caches
+// it. Errors are not cached, so a failed request is tried again. A nil cache
+// always fetches the value.
+func Cached[T any](c *Cache, key string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	e := c.entry(key)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.cached {
+		return e.value.(T), nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	e.value = value
+	e.cached = true
+	return value, nil
+}
+
+// Forget removes the value of the key, so it is fetched again.
+func (c *Cache) Forget(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// Has returns whether a value is cached for the key
+func (c *Cache) Has(key string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if !ok {
+		return false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.cached
+}
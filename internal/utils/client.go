@@ -82,6 +82,17 @@ func AddOrganizationHeader(organizationId string) sdk.RequestEditorFn {
 	}
 }
 
+// AddQueryParameter adds a query parameter that the client has no parameter
+// for, like a filter on the fields of entries
+func AddQueryParameter(key, value string) sdk.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set(key, value)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
 type Response interface {
 	StatusCode() int
 }
@@ -8,4 +8,5 @@ type ProviderData struct {
 	Client         *sdk.ClientWithResponses
 	ClientUpload   *sdk.ClientWithResponses
 	OrganizationId string
+	Cache          *Cache
 }
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// ContentTypeKey is the cache key of a content type that is read from Contentful
+func ContentTypeKey(spaceID, environment, id string) string {
+	return "content_type/" + spaceID + "/" + environment + "/" + id
+}
+
+// PlannedContentTypeKey is the cache key of a content type that is planned in
+// this run. Resources that are planned after it can refer to it before it is
+// created.
+func PlannedContentTypeKey(spaceID, environment, id string) string {
+	return "planned_content_type/" + spaceID + "/" + environment + "/" + id
+}
+
+// CachedContentType returns the content type from the cache, or reads it from
+// Contentful. It returns nil when the content type does not exist.
+func CachedContentType(ctx context.Context, client *sdk.ClientWithResponses, cache *Cache, spaceID, environment, id string) (*sdk.ContentType, error) {
+	return Cached(cache, ContentTypeKey(spaceID, environment, id), func() (*sdk.ContentType, error) {
+		resp, err := client.GetContentTypeWithResponse(ctx, spaceID, environment, id)
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		if err := CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	})
+}
+
+// PlanContentType records that the content type is planned in this run with
+// the IDs of its fields, which are nil when they are not known yet.
+func PlanContentType(cache *Cache, spaceID, environment, id string, fieldIDs []string) {
+	_, _ = Cached(cache, PlannedContentTypeKey(spaceID, environment, id), func() ([]string, error) {
+		return fieldIDs, nil
+	})
+}
+
+// PlannedContentType returns the IDs of the fields of a content type that is
+// planned in this run, and whether it is planned.
+func PlannedContentType(cache *Cache, spaceID, environment, id string) ([]string, bool) {
+	if !cache.Has(PlannedContentTypeKey(spaceID, environment, id)) {
+		return nil, false
+	}
+	fieldIDs, _ := Cached(cache, PlannedContentTypeKey(spaceID, environment, id), func() ([]string, error) {
+		return nil, nil
+	})
+	return fieldIDs, true
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The deletion policies of entries and assets, which decide what happens to
+// the content when the resource is destroyed.
+const (
+	DeletionPolicyDelete    = "delete"
+	DeletionPolicyArchive   = "archive"
+	DeletionPolicyUnpublish = "unpublish"
+	DeletionPolicyRetain    = "retain"
+)
+
+var DeletionPolicies = []string{
+	DeletionPolicyDelete,
+	DeletionPolicyArchive,
+	DeletionPolicyUnpublish,
+	DeletionPolicyRetain,
+}
+
+// DeletionWarning describes what happens to the content when the resource is
+// destroyed. It returns false when an unpublished resource is deleted, which
+// is what a destroy already shows.
+func DeletionWarning(kind string, id string, policy string, published bool) (string, string, bool) {
+	switch policy {
+	case DeletionPolicyArchive:
+		return kind + " will be archived",
+			fmt.Sprintf("%s %s is not deleted but unpublished and archived, because deletion_policy is archive", kind, id), true
+	case DeletionPolicyUnpublish:
+		return kind + " will be unpublished",
+			fmt.Sprintf("%s %s is not deleted but unpublished, because deletion_policy is unpublish", kind, id), true
+	case DeletionPolicyRetain:
+		return kind + " will be kept",
+			fmt.Sprintf("%s %s is removed from the state but kept unchanged in Contentful, because deletion_policy is retain", kind, id), true
+	}
+
+	if !published {
+		return "", "", false
+	}
+	return "Published " + strings.ToLower(kind) + " will be deleted",
+		fmt.Sprintf("%s %s is published and will be deleted, set deletion_policy to archive, unpublish or retain to keep the content", kind, id), true
+}
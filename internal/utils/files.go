@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// HashFile returns the hex encoded sha256 of the file at the given path,
+// reading it in chunks so large files are not loaded into memory.
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// HashContent returns the hex encoded sha256 of the given content, matching
+// the hash HashFile returns for a file with the same content.
+func HashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// UploadFile streams the file at the given path to the upload API and returns
+// the created upload together with the sha256 of the streamed content.
+func UploadFile(ctx context.Context, client *sdk.ClientWithResponses, spaceID, environment, path string) (*sdk.Upload, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	return upload(ctx, client, spaceID, environment, f)
+}
+
+// UploadContent sends the given content to the upload API and returns the
+// created upload together with the sha256 of the content.
+func UploadContent(ctx context.Context, client *sdk.ClientWithResponses, spaceID, environment string, content []byte) (*sdk.Upload, string, error) {
+	return upload(ctx, client, spaceID, environment, bytes.NewReader(content))
+}
+
+func upload(ctx context.Context, client *sdk.ClientWithResponses, spaceID, environment string, body io.Reader) (*sdk.Upload, string, error) {
+	h := sha256.New()
+	resp, err := client.CreateUploadWithBodyWithResponse(ctx, spaceID, environment, "application/octet-stream", io.TeeReader(body, h))
+	if err := CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		return nil, "", err
+	}
+
+	return resp.JSON201, hex.EncodeToString(h.Sum(nil)), nil
+}
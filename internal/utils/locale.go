@@ -0,0 +1,14 @@
+package utils
+
+// PlannedLocaleKey is the cache key of a locale that is planned in this run
+func PlannedLocaleKey(spaceID, environment, code string) string {
+	return "planned_locale/" + spaceID + "/" + environment + "/" + code
+}
+
+// PlanLocale records that the locale is planned in this run, so entries that
+// are planned after it can use it before it is created.
+func PlanLocale(cache *Cache, spaceID, environment, code string) {
+	_, _ = Cached(cache, PlannedLocaleKey(spaceID, environment, code), func() (bool, error) {
+		return true, nil
+	})
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// MetadataDraft creates the metadata of an entry or asset for a request. A set
+// that is not known yet is left out, so Contentful keeps its current value.
+func MetadataDraft(tags, concepts types.Set) *sdk.ContentMetadata {
+	return &sdk.ContentMetadata{
+		Tags:     metadataLinks(tags, "Tag"),
+		Concepts: metadataLinks(concepts, "TaxonomyConcept"),
+	}
+}
+
+// MetadataTags returns the IDs of the tags in the metadata
+func MetadataTags(metadata *sdk.ContentMetadata) types.Set {
+	if metadata == nil {
+		return linkIDs(nil)
+	}
+	return linkIDs(metadata.Tags)
+}
+
+// MetadataConcepts returns the IDs of the taxonomy concepts in the metadata
+func MetadataConcepts(metadata *sdk.ContentMetadata) types.Set {
+	if metadata == nil {
+		return linkIDs(nil)
+	}
+	return linkIDs(metadata.Concepts)
+}
+
+// ManagedTags returns the tags of current that are also in managed, used to
+// ignore the tags that are added outside of Terraform.
+func ManagedTags(current, managed types.Set) types.Set {
+	keep := map[string]bool{}
+	for _, id := range setStrings(managed) {
+		keep[id] = true
+	}
+
+	values := []attr.Value{}
+	for _, id := range setStrings(current) {
+		if keep[id] {
+			values = append(values, types.StringValue(id))
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+// MergeUnmanagedTags adds the tags of current that were not managed in the
+// previous state to the metadata of a request, so tags that are added outside
+// of Terraform are kept.
+func MergeUnmanagedTags(draft *sdk.ContentMetadata, previous types.Set, current *sdk.ContentMetadata) {
+	managed := map[string]bool{}
+	for _, id := range setStrings(previous) {
+		managed[id] = true
+	}
+
+	tags := []sdk.SystemPropertiesReference{}
+	if draft.Tags != nil {
+		tags = *draft.Tags
+	}
+	for _, link := range tags {
+		managed[link.Sys.Id] = true
+	}
+
+	for _, id := range setStrings(MetadataTags(current)) {
+		if !managed[id] {
+			tags = append(tags, metadataLink(id, "Tag"))
+		}
+	}
+	draft.Tags = &tags
+}
+
+func metadataLinks(ids types.Set, linkType string) *[]sdk.SystemPropertiesReference {
+	if ids.IsNull() || ids.IsUnknown() {
+		return nil
+	}
+
+	links := []sdk.SystemPropertiesReference{}
+	for _, id := range setStrings(ids) {
+		links = append(links, metadataLink(id, linkType))
+	}
+	return &links
+}
+
+func metadataLink(id, linkType string) sdk.SystemPropertiesReference {
+	return sdk.SystemPropertiesReference{
+		Sys: sdk.SystemPropertiesLink{
+			Type:     "Link",
+			LinkType: linkType,
+			Id:       id,
+		},
+	}
+}
+
+func linkIDs(links *[]sdk.SystemPropertiesReference) types.Set {
+	values := []attr.Value{}
+	if links != nil {
+		for _, link := range *links {
+			values = append(values, types.StringValue(link.Sys.Id))
+		}
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func setStrings(set types.Set) []string {
+	var result []string
+	for _, value := range set.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+	return result
+}
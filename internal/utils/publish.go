@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// PublishedLocales returns the locales that have a published version, also
+// when they were changed after publishing.
+func PublishedLocales(fieldStatus *map[string]map[string]string) types.Set {
+	locales := []attr.Value{}
+	if fieldStatus != nil {
+		for locale, status := range (*fieldStatus)["*"] {
+			if status == "published" || status == "changed" {
+				locales = append(locales, types.StringValue(locale))
+			}
+		}
+	}
+	return types.SetValueMust(types.StringType, locales)
+}
+
+// LocalePublishing returns the locales to publish and unpublish, so only the
+// given locales are published. Locales that are changed are published again.
+// It returns nil when nothing needs to be done.
+func LocalePublishing(fieldStatus *map[string]map[string]string, locales types.Set) *sdk.LocalePublishing {
+	wanted := []string{}
+	for _, locale := range locales.Elements() {
+		wanted = append(wanted, locale.(types.String).ValueString())
+	}
+
+	current := map[string]string{}
+	if fieldStatus != nil {
+		current = (*fieldStatus)["*"]
+	}
+
+	var add, remove []string
+	for _, locale := range wanted {
+		if current[locale] != "published" {
+			add = append(add, locale)
+		}
+	}
+	for locale, status := range current {
+		if (status == "published" || status == "changed") && !slices.Contains(wanted, locale) {
+			remove = append(remove, locale)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	result := &sdk.LocalePublishing{}
+	if len(add) > 0 {
+		result.Add = &sdk.LocalePublishingFields{Fields: map[string][]string{"*": add}}
+	}
+	if len(remove) > 0 {
+		result.Remove = &sdk.LocalePublishingFields{Fields: map[string][]string{"*": remove}}
+	}
+	return result
+}
+
+// WithJSONBody sends the value as the body of the request, for requests that
+// only have a body in some cases like publishing separate locales.
+func WithJSONBody(body any) sdk.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+		req.ContentLength = int64(len(data))
+		req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
+		return nil
+	}
+}